@@ -0,0 +1,175 @@
+package schedule
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MonthDay is a day of the year without the year, such as Jun 1 "06-01"
+type MonthDay struct {
+	month time.Month
+	day   int
+}
+
+// NewMonthDay does not normalize, use Validate to check it is a real day
+// Feb 29 is valid, in a year which is not a leap year it becomes Feb 28
+func NewMonthDay(month time.Month, day int) MonthDay {
+	return MonthDay{month: month, day: day}
+}
+
+// ParseMonthDay takes "06-01" or the ISO 8601 form "--06-01"
+func ParseMonthDay(s string) (MonthDay, error) {
+	t, err := time.Parse("01-02", strings.TrimPrefix(s, "--"))
+	if err != nil {
+		return MonthDay{}, fmt.Errorf("%w: %s", ErrInvalidMonthDayString, s)
+	}
+	return NewMonthDay(t.Month(), t.Day()), nil
+}
+
+func (d Date) MonthDay() MonthDay { return NewMonthDay(d.Month(), d.Day()) }
+
+func (md MonthDay) Month() time.Month { return md.month }
+func (md MonthDay) Day() int          { return md.day }
+func (md MonthDay) IsZero() bool      { return md == MonthDay{} }
+func (md MonthDay) String() string    { return fmt.Sprintf("%02d-%02d", int(md.month), md.day) }
+
+// Validate checks the day exists, allowing Feb 29
+func (md MonthDay) Validate() error {
+	if md.month < time.January || md.month > time.December ||
+		md.day < 1 || md.day > daysIn(md.month, 2000) {
+		return fmt.Errorf("%w: %s", ErrInvalidMonthDayString, md)
+	}
+	return nil
+}
+
+// In is the date in year, Feb 29 is Feb 28 when year is not a leap year
+func (md MonthDay) In(year int) Date {
+	if day := daysIn(md.month, year); md.day > day {
+		return NewDate(year, md.month, day)
+	}
+	return NewDate(year, md.month, md.day)
+}
+
+func (md MonthDay) MarshalText() (text []byte, err error) {
+	return []byte(md.String()), nil
+}
+
+func (md *MonthDay) UnmarshalText(text []byte) error {
+	v, err := ParseMonthDay(string(text))
+	if err != nil {
+		return err
+	}
+	*md = v
+	return nil
+}
+
+func (md MonthDay) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(md.String())
+	buffer.WriteString(`"`)
+	return buffer.Bytes(), nil
+}
+
+func (md *MonthDay) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	return md.UnmarshalText([]byte(s))
+}
+
+// AnnualDateRange is a DateRange which happens every year, such as
+// summer from 06-01 until 08-31, both days are included like DateRange
+// when Until is before From it crosses New Year, winter from 11-15 until 03-15
+type AnnualDateRange struct {
+	From  MonthDay `json:"from"`
+	Until MonthDay `json:"until"`
+}
+
+func NewAnnualDateRange(from, until MonthDay) AnnualDateRange {
+	return AnnualDateRange{From: from, Until: until}
+}
+
+func (a AnnualDateRange) String() string {
+	return "every year from " + a.From.String() + " until " + a.Until.String()
+}
+
+func (a AnnualDateRange) Validate() error {
+	if err := a.From.Validate(); err != nil {
+		return err
+	}
+	return a.Until.Validate()
+}
+
+// CrossesNewYear is true when Until is before From
+func (a AnnualDateRange) CrossesNewYear() bool {
+	return a.Until.month < a.From.month ||
+		a.Until.month == a.From.month && a.Until.day < a.From.day
+}
+
+// Starting is the occurrence which starts in year
+// when it crosses New Year it ends the following year
+func (a AnnualDateRange) Starting(year int) DateRange {
+	var until = a.Until.In(year)
+	if a.CrossesNewYear() {
+		until = a.Until.In(year + 1)
+	}
+	return NewDateRangeUntil(a.From.In(year), &until)
+}
+
+// InYear is the part of each occurrence which is within year
+// so winter 11-15 until 03-15 in 2026 is Jan 1 - Mar 15 and Nov 15 - Dec 31
+func (a AnnualDateRange) InYear(year int) DateRangeSet {
+	var (
+		jan1  = NewDate(year, time.January, 1)
+		dec31 = NewDate(year, time.December, 31)
+	)
+	set, _ := a.Within(NewDateRangeUntil(jan1, &dec31))
+	return set
+}
+
+// Within is every occurrence which overlaps dr, cut down to fit within dr
+// ErrInfiniteDateRange is returned when dr has no end
+func (a AnnualDateRange) Within(dr DateRange) (DateRangeSet, error) {
+	if !dr.HasDays() {
+		return NewDateRangeSet(), nil
+	}
+	if dr.DayCount() == InfDays {
+		return DateRangeSet{}, ErrInfiniteDateRange
+	}
+
+	var ranges = make([]DateRange, 0)
+	for year := dr.From.Year() - 1; year <= dr.Until.Year(); year++ {
+		if m := a.Starting(year).Merge(dr); m.HasDays() {
+			ranges = append(ranges, m)
+		}
+	}
+	return NewDateRangeSet(ranges...), nil
+}
+
+// ContainsDate is true when d is within the occurrence of any year
+func (a AnnualDateRange) ContainsDate(d Date) bool {
+	return a.Starting(d.Year()).ContainsDate(d) ||
+		a.Starting(d.Year()-1).ContainsDate(d)
+}
+
+// Overlaps is true when the two share any day of the year
+// both a leap year and a common year are checked because of Feb 29
+func (a AnnualDateRange) Overlaps(a2 AnnualDateRange) bool {
+	for _, year := range []int{2000, 2001} {
+		for _, other := range []DateRange{a2.Starting(year - 1), a2.Starting(year), a2.Starting(year + 1)} {
+			if a.Starting(year).Overlaps(other) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+package schedule_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestMonthDay(t *testing.T) {
+	for in, expect := range map[string]string{
+		"06-01":   "06-01",
+		"--12-31": "12-31",
+		"02-29":   "02-29",
+	} {
+		md, err := schedule.ParseMonthDay(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, expect, md.String(), in)
+		assert.NoError(t, md.Validate(), in)
+	}
+	for _, in := range []string{"", "13-01", "02-30", "6-1x", "2026-06-01"} {
+		_, err := schedule.ParseMonthDay(in)
+		assert.ErrorIs(t, err, schedule.ErrInvalidMonthDayString, in)
+	}
+	assert.ErrorIs(t, schedule.NewMonthDay(time.April, 31).Validate(), schedule.ErrInvalidMonthDayString)
+
+	feb29 := schedule.NewMonthDay(time.February, 29)
+	assert.Equal(t, "2028-02-29", feb29.In(2028).String())
+	assert.Equal(t, "2026-02-28", feb29.In(2026).String())
+	assert.Equal(t, feb29, schedule.NewDate(2028, 2, 29).MonthDay())
+
+	b, err := json.Marshal(feb29)
+	require.NoError(t, err)
+	assert.Equal(t, `"02-29"`, string(b))
+	var md schedule.MonthDay
+	require.NoError(t, json.Unmarshal(b, &md))
+	assert.Equal(t, feb29, md)
+}
+
+func TestAnnualDateRange(t *testing.T) {
+	var (
+		md     = func(m time.Month, d int) schedule.MonthDay { return schedule.NewMonthDay(m, d) }
+		summer = schedule.NewAnnualDateRange(md(time.June, 1), md(time.August, 31))
+		winter = schedule.NewAnnualDateRange(md(time.November, 15), md(time.March, 15))
+		leap   = schedule.NewAnnualDateRange(md(time.February, 29), md(time.February, 29))
+		date   = schedule.NewDate
+	)
+
+	t.Run("Starting InYear", func(t *testing.T) {
+		assert.False(t, summer.CrossesNewYear())
+		assert.True(t, winter.CrossesNewYear())
+		assert.Equal(t, "from 2026-06-01 until 2026-08-31", summer.Starting(2026).String())
+		assert.Equal(t, "from 2026-11-15 until 2027-03-15", winter.Starting(2026).String())
+		assert.Equal(t, "from 2026-06-01 until 2026-08-31", summer.InYear(2026).String())
+		assert.Equal(t,
+			"from 2026-01-01 until 2026-03-15, from 2026-11-15 until 2026-12-31",
+			winter.InYear(2026).String())
+		assert.Equal(t, "from 2026-02-28 until 2026-02-28", leap.InYear(2026).String())
+		assert.Equal(t, "from 2028-02-29 until 2028-02-29", leap.InYear(2028).String())
+	})
+
+	t.Run("ContainsDate", func(t *testing.T) {
+		var tests = map[string]struct {
+			a        schedule.AnnualDateRange
+			d        schedule.Date
+			contains bool
+		}{
+			"summer start":        {summer, date(2026, 6, 1), true},
+			"summer end":          {summer, date(2030, 8, 31), true},
+			"before summer":       {summer, date(2026, 5, 31), false},
+			"after summer":        {summer, date(2026, 9, 1), false},
+			"winter december":     {winter, date(2026, 12, 25), true},
+			"winter january":      {winter, date(2027, 1, 1), true},
+			"winter end":          {winter, date(2027, 3, 15), true},
+			"after winter":        {winter, date(2027, 3, 16), false},
+			"before winter":       {winter, date(2026, 11, 14), false},
+			"feb 29 in leap year": {leap, date(2028, 2, 29), true},
+			"feb 28 in leap year": {leap, date(2028, 2, 28), false},
+			"feb 28 common year":  {leap, date(2026, 2, 28), true},
+		}
+		for name, tc := range tests {
+			assert.Equal(t, tc.contains, tc.a.ContainsDate(tc.d), name)
+		}
+	})
+
+	t.Run("Overlaps", func(t *testing.T) {
+		var (
+			spring = schedule.NewAnnualDateRange(md(time.March, 1), md(time.May, 31))
+			feb28  = schedule.NewAnnualDateRange(md(time.February, 28), md(time.February, 28))
+		)
+		assert.False(t, summer.Overlaps(winter))
+		assert.False(t, summer.Overlaps(spring))
+		assert.True(t, winter.Overlaps(spring))
+		assert.True(t, spring.Overlaps(winter))
+		assert.True(t, winter.Overlaps(schedule.NewAnnualDateRange(md(time.December, 1), md(time.January, 31))))
+		assert.True(t, leap.Overlaps(feb28))
+		assert.True(t, summer.Overlaps(summer))
+	})
+
+	t.Run("Within", func(t *testing.T) {
+		var (
+			from  = date(2026, 2, 1)
+			until = date(2027, 12, 1)
+		)
+		set, err := winter.Within(schedule.NewDateRangeUntil(from, &until))
+		require.NoError(t, err)
+		assert.Equal(t,
+			"from 2026-02-01 until 2026-03-15, from 2026-11-15 until 2027-03-15, from 2027-11-15 until 2027-12-01",
+			set.String())
+
+		_, err = winter.Within(schedule.NewDateRangeUntil(from, nil))
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(winter)
+		require.NoError(t, err)
+		assert.Equal(t, `{"from":"11-15","until":"03-15"}`, string(b))
+		var a schedule.AnnualDateRange
+		require.NoError(t, json.Unmarshal(b, &a))
+		assert.Equal(t, winter, a)
+		assert.Equal(t, "every year from 11-15 until 03-15", a.String())
+	})
+}
+
+func TestSchedule_Season(t *testing.T) {
+	var (
+		summer = schedule.NewAnnualDateRange(
+			schedule.NewMonthDay(time.June, 1), schedule.NewMonthDay(time.August, 31))
+		mon = schedule.WeekdayTimeSlotFromString("Monday 09:00-17:00")
+		jan = schedule.NewDate(2026, 1, 1)
+		dec = schedule.NewDate(2027, 12, 31)
+
+		everySummer = schedule.NewSchedule(schedule.DateRange{}, mon).WithSeason(summer)
+		summer2026  = schedule.NewSchedule(schedule.NewDateRangeUntil(jan, schedule.NewDate(2026, 12, 31).Pointer()), mon).WithSeason(summer)
+	)
+
+	assert.NoError(t, everySummer.Validate())
+	assert.NoError(t, summer2026.Validate())
+	assert.False(t, everySummer.IsEmpty())
+	var (
+		badSummer = schedule.NewAnnualDateRange(schedule.MonthDay{}, summer.Until)
+		seasonErr schedule.SeasonError
+	)
+	err := everySummer.WithSeason(badSummer).Validate()
+	require.ErrorAs(t, err, &seasonErr)
+	assert.Equal(t, badSummer, seasonErr.Season)
+	assert.ErrorIs(t, err, schedule.ErrInvalidMonthDayString)
+	assert.ErrorIs(t, schedule.NewSchedule(schedule.DateRange{}, mon).Validate(), schedule.ErrFromRequired)
+
+	byDate, err := schedule.NewCalendar(summer2026).ByDateRange(schedule.NewDateRangeUntil(jan, &dec))
+	require.NoError(t, err)
+	assert.Len(t, byDate, 92)
+	assert.False(t, byDate.HasDate(schedule.NewDate(2026, 5, 31)))
+	assert.True(t, byDate.HasDate(schedule.NewDate(2026, 6, 1)))
+	assert.Equal(t, []schedule.WeekdayTimeSlot{mon}, byDate[schedule.NewDate(2026, 6, 1)])
+
+	byDate, err = schedule.NewCalendar(everySummer).ByDateRange(schedule.NewDateRangeUntil(jan, &dec))
+	require.NoError(t, err)
+	assert.Len(t, byDate, 92*2)
+
+	// ByDate begins with the latest summer which has started by limit
+	byDate = schedule.NewCalendar(everySummer).ByDate(schedule.NewDate(2027, 8, 31))
+	assert.Len(t, byDate, 92)
+	assert.True(t, byDate.HasDate(schedule.NewDate(2027, 6, 1)))
+	byDate = schedule.NewCalendar(everySummer).ByDate(schedule.NewDate(2027, 7, 1))
+	assert.Len(t, byDate, 31)
+	byDate = schedule.NewCalendar(everySummer).ByDate(schedule.NewDate(2027, 3, 1))
+	assert.Len(t, byDate, 92)
+	assert.True(t, byDate.HasDate(schedule.NewDate(2026, 6, 1)))
+
+	// nor does another schedule with an earlier start change which summer
+	var (
+		apr1  = schedule.NewDate(2027, 4, 1)
+		apr7  = schedule.NewDate(2027, 4, 7)
+		april = schedule.NewSchedule(schedule.NewDateRangeUntil(apr1, &apr7), mon)
+	)
+	byDate = schedule.NewCalendar(everySummer, april).ByDate(schedule.NewDate(2027, 8, 31))
+	assert.Len(t, byDate, 92+7)
+	assert.False(t, byDate.HasDate(schedule.NewDate(2027, 5, 31)))
+
+	// summer 2026 has 14 Mondays and 2027 has 13
+	total, err := everySummer.TotalDuration(schedule.NewDateRangeUntil(jan, &dec))
+	require.NoError(t, err)
+	assert.Equal(t, 27*8*time.Hour, total)
+
+	_, err = everySummer.TotalDuration(schedule.NewDateRangeUntil(jan, nil))
+	assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+}
@@ -0,0 +1,133 @@
+package schedule
+
+// IsBusinessDay is true when d is not in weekend and is not a holiday
+// holidays may be nil
+func (d Date) IsBusinessDay(weekend WeekdaySet, holidays HolidayProvider) bool {
+	return !weekend.Contains(d.Weekday()) && !isHoliday(holidays, d)
+}
+
+// NextBusinessDay is the first business day after d
+// ErrNoBusinessDay is returned when there is none, see AddBusinessDays
+func (d Date) NextBusinessDay(weekend WeekdaySet, holidays HolidayProvider) (Date, error) {
+	return d.AddBusinessDays(1, weekend, holidays)
+}
+
+// PreviousBusinessDay is the last business day before d
+// ErrNoBusinessDay is returned when there is none, see AddBusinessDays
+func (d Date) PreviousBusinessDay(weekend WeekdaySet, holidays HolidayProvider) (Date, error) {
+	return d.AddBusinessDays(-1, weekend, holidays)
+}
+
+// AddBusinessDays is the n'th business day after d, or before d when n is negative
+// so "10 business days after" an invoice date is AddBusinessDays(10, Weekend, holidays)
+// d itself is never counted, 0 returns d even when it is not a business day
+//
+// whole weeks are skipped at once so without holidays it is O(1),
+// holidays are checked one date at a time over the dates skipped, a
+// HolidayCalendar finds its holidays for those dates once as a DateSet
+//
+// ErrNoBusinessDay is returned when every weekday is in weekend, or when a
+// whole year goes by without a business day, such as when every date is a holiday
+func (d Date) AddBusinessDays(n int, weekend WeekdaySet, holidays HolidayProvider) (Date, error) {
+	if n == 0 {
+		return d, nil
+	}
+	if weekend.Complement().IsEmpty() {
+		return Date{}, ErrNoBusinessDay
+	}
+	var (
+		step int64 = 1
+		// idle is how many dates have gone by without a business day
+		idle int64
+	)
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for {
+		var next = d.addWorkdays(n, step, weekend)
+		if holidays == nil {
+			return next, nil
+		}
+		// holidays which were skipped over still have to be made up
+		var (
+			skipped = NewDateRangeUntil(*MinDate(&d, &next), MaxDate(&d, &next))
+			closed  = holidaysWithin(holidays, skipped)
+			wanted  = n
+		)
+		n = 0
+		for day := d.addDays(step); day != next.addDays(step); day = day.addDays(step) {
+			if day.IsBusinessDay(weekend, nil) && closed.IsHoliday(day) {
+				n++
+			}
+		}
+		if n == 0 {
+			return next, nil
+		}
+		idle += (next.days - d.days) * step
+		if n < wanted {
+			idle = 0
+		}
+		if idle > 366 {
+			return Date{}, ErrNoBusinessDay
+		}
+		d = next
+	}
+}
+
+// addWorkdays moves n days which are not in weekend, forward or back by step
+// any 7 dates in a row have the same number of workdays so whole weeks are skipped
+func (d Date) addWorkdays(n int, step int64, weekend WeekdaySet) Date {
+	var (
+		perWeek = weekend.Complement().Len()
+		weeks   = n / perWeek
+		rest    = n % perWeek
+	)
+	// end on a workday even when d is not one
+	if rest == 0 {
+		weeks, rest = weeks-1, perWeek
+	}
+	d = d.addDays(int64(weeks) * 7 * step)
+	for rest > 0 {
+		d = d.addDays(step)
+		if !weekend.Contains(d.Weekday()) {
+			rest--
+		}
+	}
+	return d
+}
+
+func (d Date) addDays(n int64) Date { return Date{d.days + n} }
+
+// BusinessDaysBetween is the number of business days after d up to and including end
+// so it is n for d.AddBusinessDays(n, ...), and negative when end is before d
+// weekends are counted in O(1), holidays are checked one date at a time
+func (d Date) BusinessDaysBetween(end Date, weekend WeekdaySet, holidays HolidayProvider) int {
+	if end.Before(d) {
+		// count end but not d, the mirror of counting d but not end
+		return -end.addDays(-1).BusinessDaysBetween(d.addDays(-1), weekend, holidays)
+	}
+	var dr = NewDateRangeUntil(d.addDays(1), &end)
+	if !dr.HasDays() {
+		return 0
+	}
+
+	// the range has an end so WeekdayCounts never returns ErrInfiniteDateRange
+	counts, _ := dr.WeekdayCounts()
+	var n int
+	for w, count := range counts {
+		if !weekend.Contains(w) {
+			n += count
+		}
+	}
+	if holidays == nil {
+		return n
+	}
+	var closed = holidaysWithin(holidays, dr)
+	_ = dr.Days(func(day Date) bool {
+		if day.IsBusinessDay(weekend, nil) && closed.IsHoliday(day) {
+			n--
+		}
+		return true
+	})
+	return n
+}
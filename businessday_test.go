@@ -0,0 +1,133 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestDate_businessDays(t *testing.T) {
+	var (
+		date         = schedule.NewDate
+		weekend      = schedule.Weekend
+		thanksgiving = schedule.NewHolidayCalendar("",
+			schedule.NthWeekdayHoliday("Thanksgiving", 4, schedule.Thursday, time.November),
+			schedule.FixedHoliday("Christmas", time.December, 25).WithObserved(schedule.ObservedNearestWeekday),
+		)
+		friSat = schedule.NewWeekdaySet(schedule.Friday, schedule.Saturday)
+	)
+
+	t.Run("AddBusinessDays", func(t *testing.T) {
+		var tests = map[string]struct {
+			from     schedule.Date
+			n        int
+			weekend  schedule.WeekdaySet
+			holidays schedule.HolidayProvider
+			expect   schedule.Date
+		}{
+			"zero":               {date(2026, 10, 17), 0, weekend, nil, date(2026, 10, 17)},
+			"friday plus one":    {date(2026, 10, 16), 1, weekend, nil, date(2026, 10, 19)},
+			"saturday plus one":  {date(2026, 10, 17), 1, weekend, nil, date(2026, 10, 19)},
+			"saturday plus five": {date(2026, 10, 17), 5, weekend, nil, date(2026, 10, 23)},
+			"ten after friday":   {date(2026, 10, 16), 10, weekend, nil, date(2026, 10, 30)},
+			"monday minus one":   {date(2026, 10, 19), -1, weekend, nil, date(2026, 10, 16)},
+			"sunday minus five":  {date(2026, 10, 18), -5, weekend, nil, date(2026, 10, 12)},
+			"no weekend":         {date(2026, 10, 16), 3, 0, nil, date(2026, 10, 19)},
+			"friday saturday":    {date(2026, 10, 15), 1, friSat, nil, date(2026, 10, 18)},
+			"over thanksgiving":  {date(2026, 11, 25), 1, weekend, thanksgiving, date(2026, 11, 27)},
+			"onto observed":      {date(2026, 12, 23), 2, weekend, thanksgiving, date(2026, 12, 28)},
+			"back over both":     {date(2026, 12, 28), -23, weekend, thanksgiving, date(2026, 11, 23)},
+			"year of weekdays":   {date(2026, 1, 1), 261, weekend, nil, date(2027, 1, 1)},
+			"huge":               {date(2026, 10, 16), 5_000_000, weekend, nil, date(2026, 10, 16).AddDate(0, 0, 7_000_000)},
+		}
+		for name, tc := range tests {
+			got, err := tc.from.AddBusinessDays(tc.n, tc.weekend, tc.holidays)
+			require.NoError(t, err, name)
+			assert.Equal(t, tc.expect.String(), got.String(), name)
+			assert.Equal(t, tc.n, tc.from.BusinessDaysBetween(got, tc.weekend, tc.holidays), name)
+		}
+
+		everyDay := schedule.NewWeekdaySet().Complement()
+		_, err := date(2026, 10, 16).AddBusinessDays(5, everyDay, nil)
+		assert.ErrorIs(t, err, schedule.ErrNoBusinessDay)
+
+		// no business day within a year gives up rather than looping forever
+		var (
+			always = schedule.HolidayFunc(func(schedule.Date) bool { return true })
+			fri    = date(2026, 10, 16)
+		)
+		_, err = fri.AddBusinessDays(5, weekend, always)
+		assert.ErrorIs(t, err, schedule.ErrNoBusinessDay)
+		_, err = fri.AddBusinessDays(-5, weekend, always)
+		assert.ErrorIs(t, err, schedule.ErrNoBusinessDay)
+		_, err = fri.NextBusinessDay(weekend, always)
+		assert.ErrorIs(t, err, schedule.ErrNoBusinessDay)
+		_, err = fri.PreviousBusinessDay(weekend, always)
+		assert.ErrorIs(t, err, schedule.ErrNoBusinessDay)
+
+		// a long closure which ends is still found
+		closedUntil := schedule.HolidayFunc(func(d schedule.Date) bool { return d.Before(date(2027, 6, 1)) })
+		next, err := fri.NextBusinessDay(weekend, closedUntil)
+		require.NoError(t, err)
+		assert.Equal(t, date(2027, 6, 1), next)
+	})
+
+	t.Run("matches one day at a time", func(t *testing.T) {
+		var start = date(2026, 11, 1)
+		for n := -60; n <= 60; n++ {
+			var (
+				d    = start
+				step = 1
+			)
+			if n < 0 {
+				step = -1
+			}
+			for i := 0; i != n; {
+				d = d.AddDate(0, 0, step)
+				if d.IsBusinessDay(friSat, thanksgiving) {
+					i += step
+				}
+			}
+			got, err := start.AddBusinessDays(n, friSat, thanksgiving)
+			require.NoError(t, err, n)
+			require.Equal(t, d, got, n)
+		}
+	})
+
+	t.Run("IsBusinessDay Next Previous", func(t *testing.T) {
+		assert.True(t, date(2026, 10, 16).IsBusinessDay(weekend, nil))
+		assert.False(t, date(2026, 10, 17).IsBusinessDay(weekend, nil))
+		assert.False(t, date(2026, 11, 26).IsBusinessDay(weekend, thanksgiving))
+		assert.True(t, date(2026, 11, 26).IsBusinessDay(weekend, nil))
+		for name, tc := range map[string]struct {
+			got    func() (schedule.Date, error)
+			expect schedule.Date
+		}{
+			"next over thanksgiving":     {func() (schedule.Date, error) { return date(2026, 11, 25).NextBusinessDay(weekend, thanksgiving) }, date(2026, 11, 27)},
+			"previous over thanksgiving": {func() (schedule.Date, error) { return date(2026, 11, 27).PreviousBusinessDay(weekend, thanksgiving) }, date(2026, 11, 25)},
+			"next after saturday":        {func() (schedule.Date, error) { return date(2026, 10, 17).NextBusinessDay(weekend, nil) }, date(2026, 10, 19)},
+			"previous before sunday":     {func() (schedule.Date, error) { return date(2026, 10, 18).PreviousBusinessDay(weekend, nil) }, date(2026, 10, 16)},
+		} {
+			got, err := tc.got()
+			require.NoError(t, err, name)
+			assert.Equal(t, tc.expect, got, name)
+		}
+	})
+
+	t.Run("BusinessDaysBetween", func(t *testing.T) {
+		var (
+			fri = date(2026, 10, 16)
+			sat = date(2026, 10, 17)
+			mon = date(2026, 10, 19)
+		)
+		assert.Equal(t, 0, fri.BusinessDaysBetween(fri, weekend, nil))
+		assert.Equal(t, 0, fri.BusinessDaysBetween(sat, weekend, nil))
+		assert.Equal(t, 1, fri.BusinessDaysBetween(mon, weekend, nil))
+		assert.Equal(t, -1, mon.BusinessDaysBetween(fri, weekend, nil))
+		assert.Equal(t, 261, date(2025, 12, 31).BusinessDaysBetween(date(2026, 12, 31), weekend, nil))
+		assert.Equal(t, 259, date(2025, 12, 31).BusinessDaysBetween(date(2026, 12, 31), weekend, thanksgiving))
+	})
+}
@@ -107,6 +107,7 @@ func (c *Clock) Scan(src interface{}) error {
 	return nil
 }
 
+// ToTime is the moment the clock shows this time on date in loc
 func (c Clock) ToTime(date Date, loc *time.Location) time.Time {
 	return time.Date(
 		date.Year(), date.Month(), date.Day(),
@@ -20,36 +20,61 @@ var _ encoding.TextUnmarshaler = (*Date)(nil)
 var _ sql.Scanner = (*Date)(nil)
 var _ driver.Valuer = (*Date)(nil)
 
+// Date is stored as a number of days so that comparisons, Weekday and Sub
+// are simple integer math rather than building a time.Time
+// the zero value is a date millions of years ago which means "not set"
 type Date struct {
-	year  int
-	month time.Month
-	day   int
+	days int64 // days since 1970-01-01 plus zeroDateOffset
 }
 
+// zeroDateOffset moves 1970-01-01 far from zero so the zero value of
+// Date is not a date anyone will use, while still sorting before them all
+const zeroDateOffset = 1 << 32
+
 // ZeroDate is just a zero value Date
 // it is good for json decoding and sql scanning
 func ZeroDate() *Date {
 	return &Date{}
 }
 
+// Today is the current date in the time zone of the process, time.Local
+// when that may differ from the user's time zone use TodayIn
 func Today() Date {
-	t := time.Now()
-	return NewDate(t.Year(), t.Month(), t.Day())
+	return TodayIn(time.Local)
 }
 
+// TodayIn is the current date in loc
+func TodayIn(loc *time.Location) Date {
+	return NewDateFromTimeIn(time.Now(), loc)
+}
+
+// NewDate normalizes the same way time.Date does
+// so NewDate(2022, 13, 32) is 2023-02-01
 func NewDate(year int, month time.Month, day int) Date {
-	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
-	return Date{
-		year:  t.Year(),
-		month: t.Month(),
-		day:   t.Day(),
+	m := int(month) - 1
+	year += m / 12
+	if m %= 12; m < 0 {
+		m += 12
+		year--
 	}
+	return dateFromUnixDays(unixDaysFromCivil(int64(year), m+1, 1) + int64(day) - 1)
 }
 
+// NewDateFromTime is the date of t in t's own location
+// so 2022-07-09T23:30:00-05:00 is 2022-07-09
 func NewDateFromTime(t time.Time) Date {
 	return NewDate(t.Year(), t.Month(), t.Day())
 }
 
+// NewDateFromTimeIn is the date of t after converting it into loc
+// so 2022-07-09T23:30:00-05:00 in UTC is 2022-07-10
+func NewDateFromTimeIn(t time.Time, loc *time.Location) Date {
+	return NewDateFromTime(t.In(loc))
+}
+
+// ParseDate takes the "2006-01-02" format and ignores anything after it
+// so the date of a timestamp is kept as written, any offset is not used
+// see ParseDateInLocation to convert a timestamp into a location first
 func ParseDate(s string) *Date {
 	if len(s) > len(ymdFormat) {
 		s = s[0:len(ymdFormat)] // only keep the first bit in case string includes time info
@@ -63,36 +88,122 @@ func ParseDate(s string) *Date {
 }
 
 func newDateFromTime(t time.Time) Date {
-	return Date{
-		year:  t.Year(),
-		month: t.Month(),
-		day:   t.Day(),
-	}
-}
-
-func (d Date) String() string        { return d.ToTime().Format(ymdFormat) }
-func (d Date) Year() int             { return d.year }
-func (d Date) Month() time.Month     { return d.month }
-func (d Date) Day() int              { return d.day }
-func (d Date) Weekday() Weekday      { return Weekday(d.ToTime().Weekday()) }
-func (d Date) Before(date Date) bool { return d.ToTime().Before(date.ToTime()) }
-func (d Date) After(date Date) bool  { return d.ToTime().After(date.ToTime()) }
-func (d Date) Equal(date Date) bool  { return d.ToTime().Equal(date.ToTime()) }
-func (d Date) Next() Date            { return d.AddDate(0, 0, 1) }
+	return NewDate(t.Year(), t.Month(), t.Day())
+}
+
+func dateFromUnixDays(days int64) Date { return Date{days + zeroDateOffset} }
+func (d Date) unixDays() int64         { return d.days - zeroDateOffset }
+
+func (d Date) String() string {
+	y, m, day := d.civil()
+	if d.IsZero() || y < 0 || y > 9999 {
+		return d.ToTime().Format(ymdFormat)
+	}
+	b := [10]byte{
+		byte('0' + y/1000), byte('0' + y/100%10), byte('0' + y/10%10), byte('0' + y%10), '-',
+		byte('0' + m/10), byte('0' + m%10), '-',
+		byte('0' + day/10), byte('0' + day%10),
+	}
+	return string(b[:])
+}
+
+func (d Date) Year() int             { y, _, _ := d.civil(); return y }
+func (d Date) Month() time.Month     { _, m, _ := d.civil(); return time.Month(m) }
+func (d Date) Day() int              { _, _, day := d.civil(); return day }
+func (d Date) Before(date Date) bool { return d.days < date.days }
+func (d Date) After(date Date) bool  { return d.days > date.days }
+func (d Date) Equal(date Date) bool  { return d.days == date.days }
 func (d Date) Pointer() *Date        { return &d }
 func (d *Date) IsZero() bool         { return d == nil || *d == Date{} }
 
+func (d Date) Next() Date {
+	if d.IsZero() {
+		return d.AddDate(0, 0, 1)
+	}
+	return Date{d.days + 1}
+}
+
+// Weekday uses 1970-01-01 being a Thursday
+func (d Date) Weekday() Weekday {
+	if d.IsZero() {
+		return Weekday(d.ToTime().Weekday())
+	}
+	w := (d.unixDays() + int64(Thursday)) % 7
+	if w < 0 {
+		w += 7
+	}
+	return Weekday(w)
+}
+
 // Sub subtracts two dates, returning the number of days between
 //
 //	today.Sub(today)     = 0
 //	today.Sub(yesterday) = 1
 //	yesterday.Sub(today) = -1
 func (d Date) Sub(date Date) int {
-	return int(math.Round(d.ToTime().Sub(date.ToTime()).Hours() / 24))
+	if d.IsZero() || date.IsZero() {
+		return int(math.Round(d.ToTime().Sub(date.ToTime()).Hours() / 24))
+	}
+	return int(d.days - date.days)
 }
 
+// ToTime is midnight at the start of the date in UTC
+// use Clock.ToTime or LocalDateTime.In for another time or location
 func (d Date) ToTime() time.Time {
-	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, time.UTC)
+	y, m, day := d.civil()
+	return time.Date(y, time.Month(m), day, 0, 0, 0, 0, time.UTC)
+}
+
+// civil is the year, month and day of the Date
+// the zero Date is year 0, month 0, day 0 as it always has been
+func (d Date) civil() (year, month, day int) {
+	if d.IsZero() {
+		return 0, 0, 0
+	}
+	return civilFromUnixDays(d.unixDays())
+}
+
+// unixDaysFromCivil and civilFromUnixDays convert between a proleptic
+// Gregorian year, month, day and days since 1970-01-01 without time.Time
+// see http://howardhinnant.github.io/date_algorithms.html
+func unixDaysFromCivil(y int64, m, d int) int64 {
+	if m <= 2 {
+		y--
+	}
+	era := y
+	if era < 0 {
+		era -= 399
+	}
+	era /= 400
+	var (
+		yoe = y - era*400
+		mp  = int64((m + 9) % 12)
+		doy = (153*mp+2)/5 + int64(d) - 1
+		doe = yoe*365 + yoe/4 - yoe/100 + doy
+	)
+	return era*146097 + doe - 719468
+}
+
+func civilFromUnixDays(z int64) (year, month, day int) {
+	z += 719468
+	era := z
+	if era < 0 {
+		era -= 146096
+	}
+	era /= 146097
+	var (
+		doe = z - era*146097
+		yoe = (doe - doe/1460 + doe/36524 - doe/146096) / 365
+		doy = doe - (365*yoe + yoe/4 - yoe/100)
+		mp  = (5*doy + 2) / 153
+		d   = doy - (153*mp+2)/5 + 1
+		m   = (mp+2)%12 + 1
+		y   = yoe + era*400
+	)
+	if m <= 2 {
+		y++
+	}
+	return int(y), int(m), int(d)
 }
 
 func (d *Date) Date() *Date {
@@ -106,6 +217,55 @@ func (d Date) AddDate(year, month, day int) Date {
 	return NewDate(d.Year()+year, d.Month()+time.Month(month), d.Day()+day)
 }
 
+// AddMonthsClamped adds months keeping the day within the resulting month
+// unlike AddDate which rolls over into the following month
+//
+//	2026-01-31 AddMonthsClamped(1) = 2026-02-28
+//	2026-01-31 AddDate(0, 1, 0)    = 2026-03-03
+func (d Date) AddMonthsClamped(months int) Date {
+	first := NewDate(d.Year(), d.Month()+time.Month(months), 1)
+	if day, last := d.Day(), first.DaysInMonth(); day > last {
+		return first.AddDate(0, 0, last-1)
+	}
+	return first.AddDate(0, 0, d.Day()-1)
+}
+
+// AddYearsClamped adds years keeping Feb 29 as Feb 28 in non leap years
+func (d Date) AddYearsClamped(years int) Date {
+	return d.AddMonthsClamped(years * 12)
+}
+
+func (d Date) StartOfMonth() Date { return NewDate(d.Year(), d.Month(), 1) }
+func (d Date) EndOfMonth() Date   { return NewDate(d.Year(), d.Month()+1, 0) }
+func (d Date) DaysInMonth() int   { return daysIn(d.Month(), d.Year()) }
+func (d Date) IsLeapYear() bool   { return isLeapYear(d.Year()) }
+
+// StartOfWeek is the first date on or before d which is weekStart
+func (d Date) StartOfWeek(weekStart Weekday) Date {
+	offset := (int(d.Weekday()) - int(weekStart) + 7) % 7
+	return d.AddDate(0, 0, -offset)
+}
+
+// EndOfWeek is the last date of the week which starts on weekStart
+func (d Date) EndOfWeek(weekStart Weekday) Date {
+	return d.StartOfWeek(weekStart).AddDate(0, 0, 6)
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func daysIn(month time.Month, year int) int {
+	if month == time.February {
+		if isLeapYear(year) {
+			return 29
+		}
+		return 28
+	}
+	// 31, 30, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31
+	return 30 + int((month+month/8)%2)
+}
+
 func (d Date) MarshalText() (text []byte, err error) {
 	return []byte(d.String()), nil
 }
@@ -146,18 +306,31 @@ func (d *Date) UnmarshalJSON(bytes []byte) error {
 	return nil
 }
 
+// Scan implements sql.Scanner
+// strings and time.Time are the date as written, which is what a DATE column gives
+// ints are unix timestamps and use the time zone of the process, time.Local,
+// use ScanIn or DateScannerIn to choose the location
 func (d *Date) Scan(src interface{}) error {
+	return d.ScanIn(src, nil)
+}
+
+// ScanIn is Scan where unix timestamps and time.Time values are moments
+// which are converted into loc before taking the date, use this for
+// TIMESTAMP columns rather than DATE columns
+// when loc is nil time.Time is the date as written and ints use time.Local
+func (d *Date) ScanIn(src interface{}, loc *time.Location) error {
 	if src == nil {
 		return nil
 	}
 	switch t := src.(type) {
 	case int:
-		dt := time.Unix(int64(t), 0)
-		*d = newDateFromTime(dt)
+		*d = newDateFromUnix(int64(t), loc)
 	case int64:
-		dt := time.Unix(t, 0)
-		*d = newDateFromTime(dt)
+		*d = newDateFromUnix(t, loc)
 	case time.Time:
+		if loc != nil {
+			t = t.In(loc)
+		}
 		*d = newDateFromTime(t)
 	case string:
 		dt, err := time.Parse(ymdFormat, t)
@@ -177,6 +350,29 @@ func (d *Date) Scan(src interface{}) error {
 	return nil
 }
 
+func newDateFromUnix(sec int64, loc *time.Location) Date {
+	if loc == nil {
+		loc = time.Local
+	}
+	return NewDateFromTimeIn(time.Unix(sec, 0), loc)
+}
+
+// DateScannerIn is an sql.Scanner which scans into d using ScanIn
+//
+//	row.Scan(schedule.DateScannerIn(&d, loc))
+func DateScannerIn(d *Date, loc *time.Location) sql.Scanner {
+	return dateScanner{date: d, loc: loc}
+}
+
+type dateScanner struct {
+	date *Date
+	loc  *time.Location
+}
+
+func (s dateScanner) Scan(src interface{}) error {
+	return s.date.ScanIn(src, s.loc)
+}
+
 func (d Date) Value() (driver.Value, error) {
 	if d.IsZero() {
 		return nil, nil
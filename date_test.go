@@ -209,3 +209,204 @@ func TestDate_Sub(t *testing.T) {
 	assert.Equal(t, -5, d1.Sub(d6))
 	assert.Equal(t, 2, d5.Sub(d3))
 }
+
+func TestDate_matchesTime(t *testing.T) {
+	// every day from 1599 through 2401 and a few far away years
+	var (
+		start = time.Date(1599, 1, 1, 0, 0, 0, 0, time.UTC)
+		end   = time.Date(2401, 12, 31, 0, 0, 0, 0, time.UTC)
+		prev  = schedule.NewDateFromTime(start.AddDate(0, 0, -1))
+	)
+	for tm := start; !tm.After(end); tm = tm.AddDate(0, 0, 1) {
+		d := schedule.NewDate(tm.Year(), tm.Month(), tm.Day())
+		if d.Year() != tm.Year() || d.Month() != tm.Month() || d.Day() != tm.Day() {
+			t.Fatalf("%v became %v", tm, d)
+		}
+		if d.String() != tm.Format("2006-01-02") || d.Weekday() != schedule.Weekday(tm.Weekday()) ||
+			!d.ToTime().Equal(tm) || d.Sub(prev) != 1 || !prev.Before(d) || prev.Next() != d {
+			t.Fatalf("%v does not match %v", d, tm)
+		}
+		prev = d
+	}
+
+	for _, year := range []int{-4000, -1, 0, 1, 10000, 123456} {
+		tm := time.Date(year, 2, 29, 0, 0, 0, 0, time.UTC)
+		d := schedule.NewDate(year, 2, 29)
+		assert.Equal(t, tm, d.ToTime(), year)
+		assert.Equal(t, tm.Format("2006-01-02"), d.String(), year)
+		assert.Equal(t, schedule.Weekday(tm.Weekday()), d.Weekday(), year)
+	}
+
+	t.Run("normalizes like time.Date", func(t *testing.T) {
+		for _, ymd := range [][3]int{{2022, 13, 32}, {2022, 0, 0}, {2022, -13, 400}, {2020, 2, 30}} {
+			tm := time.Date(ymd[0], time.Month(ymd[1]), ymd[2], 0, 0, 0, 0, time.UTC)
+			d := schedule.NewDate(ymd[0], time.Month(ymd[1]), ymd[2])
+			assert.Equal(t, tm, d.ToTime(), ymd)
+		}
+	})
+
+	t.Run("zero date", func(t *testing.T) {
+		var d schedule.Date
+		assert.Equal(t, 0, d.Year())
+		assert.Equal(t, time.Month(0), d.Month())
+		assert.Equal(t, 0, d.Day())
+		assert.Equal(t, time.Date(0, 0, 0, 0, 0, 0, 0, time.UTC), d.ToTime())
+		assert.True(t, d.Before(schedule.NewDate(-4000, 1, 1)))
+		assert.False(t, schedule.NewDate(0, 0, 0).Pointer().IsZero())
+	})
+}
+
+var (
+	benchDate    schedule.Date
+	benchInt     int
+	benchWeekday schedule.Weekday
+)
+
+func BenchmarkDate_Before(b *testing.B) {
+	var (
+		d1 = schedule.NewDate(2022, 7, 9)
+		d2 = d1.Next()
+	)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchResult = d1.Before(d2)
+	}
+}
+
+func BenchmarkDate_Weekday(b *testing.B) {
+	d := schedule.NewDate(2022, 7, 9)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchWeekday = d.Weekday()
+	}
+}
+
+func BenchmarkDate_Sub(b *testing.B) {
+	var (
+		d1 = schedule.NewDate(2022, 7, 9)
+		d2 = schedule.NewDate(2020, 2, 29)
+	)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchInt = d1.Sub(d2)
+	}
+}
+
+func BenchmarkDate_Next(b *testing.B) {
+	d := schedule.NewDate(2022, 7, 9)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchDate = d.Next()
+	}
+}
+
+func TestDate_monthArithmetic(t *testing.T) {
+	var (
+		jan31 = schedule.NewDate(2026, 1, 31)
+		feb29 = schedule.NewDate(2024, 2, 29)
+	)
+
+	t.Run("AddMonthsClamped", func(t *testing.T) {
+		assert.Equal(t, "2026-02-28", jan31.AddMonthsClamped(1).String())
+		assert.Equal(t, "2026-03-31", jan31.AddMonthsClamped(2).String())
+		assert.Equal(t, "2026-04-30", jan31.AddMonthsClamped(3).String())
+		assert.Equal(t, "2025-12-31", jan31.AddMonthsClamped(-1).String())
+		assert.Equal(t, "2025-11-30", jan31.AddMonthsClamped(-2).String())
+		assert.Equal(t, "2028-02-29", jan31.AddMonthsClamped(25).String())
+		assert.Equal(t, "2026-01-15", schedule.NewDate(2025, 12, 15).AddMonthsClamped(1).String())
+
+		// compare with AddDate which rolls over
+		assert.Equal(t, "2026-03-03", jan31.AddDate(0, 1, 0).String())
+	})
+
+	t.Run("AddYearsClamped", func(t *testing.T) {
+		assert.Equal(t, "2025-02-28", feb29.AddYearsClamped(1).String())
+		assert.Equal(t, "2028-02-29", feb29.AddYearsClamped(4).String())
+		assert.Equal(t, "2023-02-28", feb29.AddYearsClamped(-1).String())
+	})
+
+	t.Run("month", func(t *testing.T) {
+		assert.Equal(t, "2024-02-01", feb29.StartOfMonth().String())
+		assert.Equal(t, "2024-02-29", schedule.NewDate(2024, 2, 3).EndOfMonth().String())
+		assert.Equal(t, "2026-12-31", schedule.NewDate(2026, 12, 3).EndOfMonth().String())
+
+		for year := 1999; year <= 2001; year++ {
+			for month := time.January; month <= time.December; month++ {
+				d := schedule.NewDate(year, month, 1)
+				expect := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+				assert.Equal(t, expect, d.DaysInMonth(), d.String())
+			}
+		}
+	})
+
+	t.Run("IsLeapYear", func(t *testing.T) {
+		for year, isLeap := range map[int]bool{1900: false, 2000: true, 2024: true, 2026: false} {
+			assert.Equal(t, isLeap, schedule.NewDate(year, 1, 1).IsLeapYear(), year)
+		}
+	})
+
+	t.Run("week", func(t *testing.T) {
+		wed := schedule.NewDate(2026, 10, 14) // Wednesday
+		assert.Equal(t, "2026-10-11", wed.StartOfWeek(schedule.Sunday).String())
+		assert.Equal(t, "2026-10-17", wed.EndOfWeek(schedule.Sunday).String())
+		assert.Equal(t, "2026-10-12", wed.StartOfWeek(schedule.Monday).String())
+		assert.Equal(t, "2026-10-18", wed.EndOfWeek(schedule.Monday).String())
+		assert.Equal(t, "2026-10-14", wed.StartOfWeek(schedule.Wednesday).String())
+		assert.Equal(t, "2026-10-09", wed.StartOfWeek(schedule.Friday).String())
+	})
+}
+
+func TestDate_timeZones(t *testing.T) {
+	var (
+		tokyo   = time.FixedZone("Tokyo", 9*60*60)
+		hawaii  = time.FixedZone("Hawaii", -10*60*60)
+		instant = time.Date(2022, 7, 9, 20, 0, 0, 0, time.UTC) // Jul 10 in Tokyo
+	)
+
+	t.Run("TodayIn", func(t *testing.T) {
+		for _, loc := range []*time.Location{time.UTC, tokyo, hawaii} {
+			now := time.Now().In(loc)
+			d := schedule.TodayIn(loc)
+			if now.Hour() == 23 && now.Minute() == 59 {
+				continue // avoid a flaky test at midnight
+			}
+			assert.Equal(t, schedule.NewDateFromTime(now), d, loc.String())
+			assert.Equal(t, d.Weekday(), schedule.TodayWeekdayIn(loc), loc.String())
+		}
+	})
+
+	t.Run("NewDateFromTimeIn", func(t *testing.T) {
+		assert.Equal(t, "2022-07-09", schedule.NewDateFromTime(instant).String())
+		assert.Equal(t, "2022-07-10", schedule.NewDateFromTimeIn(instant, tokyo).String())
+		assert.Equal(t, "2022-07-09", schedule.NewDateFromTimeIn(instant, hawaii).String())
+		assert.Equal(t, "2022-07-10", schedule.NewDateFromTime(instant.In(tokyo)).String())
+	})
+
+	t.Run("ScanIn", func(t *testing.T) {
+		var tests = map[string]struct {
+			src    interface{}
+			loc    *time.Location
+			expect string
+		}{
+			"unix in Tokyo":       {instant.Unix(), tokyo, "2022-07-10"},
+			"unix int in Hawaii":  {int(instant.Unix()), hawaii, "2022-07-09"},
+			"time in Tokyo":       {instant, tokyo, "2022-07-10"},
+			"time as written":     {instant, nil, "2022-07-09"},
+			"string not affected": {"2022-07-09", tokyo, "2022-07-09"},
+			"bytes not affected":  {[]byte("2022-07-09"), tokyo, "2022-07-09"},
+		}
+		for name, tc := range tests {
+			var d schedule.Date
+			require.NoError(t, d.ScanIn(tc.src, tc.loc), name)
+			assert.Equal(t, tc.expect, d.String(), name)
+
+			var d2 schedule.Date
+			require.NoError(t, schedule.DateScannerIn(&d2, tc.loc).Scan(tc.src), name)
+			assert.Equal(t, d, d2, name)
+		}
+
+		var d schedule.Date
+		require.NoError(t, d.Scan(instant.Unix()))
+		assert.Equal(t, schedule.NewDateFromTimeIn(instant, time.Local), d)
+	})
+}
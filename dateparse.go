@@ -0,0 +1,103 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseDateLayout parses s with a time.Parse layout such as "01/02/2006"
+// the date is kept as written, any time or offset in s is ignored
+func ParseDateLayout(layout, s string) (Date, error) {
+	return NewDateParser(layout).Parse(s)
+}
+
+// ParseDateInLocation parses s with a time.Parse layout and takes the date in loc
+// when s has an offset, such as RFC 3339, the moment is converted into loc first
+// so "2022-07-09T23:30:00-05:00" in UTC is 2022-07-10,
+// when s has no offset it is read as being in loc
+func ParseDateInLocation(layout, s string, loc *time.Location) (Date, error) {
+	return NewDateParser(layout).In(loc).Parse(s)
+}
+
+// DateParser parses dates which may be in one of several layouts
+type DateParser struct {
+	// Layouts are time.Parse layouts to try
+	Layouts []string
+
+	// Location, when set, is where the date is taken
+	// see ParseDateInLocation, when nil the date is kept as written
+	Location *time.Location
+}
+
+func NewDateParser(layouts ...string) DateParser {
+	return DateParser{Layouts: layouts}
+}
+
+// In sets the Location where dates are taken
+func (p DateParser) In(loc *time.Location) DateParser {
+	p.Location = loc
+	return p
+}
+
+// Parse tries every layout, it is an ErrAmbiguousDate when layouts disagree
+// such as "07/09/2022" with both "01/02/2006" and "02/01/2006"
+// and an ErrUnparsableDate when no layout matches
+func (p DateParser) Parse(s string) (Date, error) {
+	var (
+		dates   []Date
+		layouts []string
+	)
+	for _, layout := range p.Layouts {
+		d, err := p.parse(layout, s)
+		if err != nil {
+			continue
+		}
+		if !containsDate(dates, d) {
+			dates = append(dates, d)
+			layouts = append(layouts, layout)
+		}
+	}
+
+	switch len(dates) {
+	case 0:
+		return Date{}, fmt.Errorf("%w: %q does not match %s",
+			ErrUnparsableDate, s, strings.Join(p.Layouts, ", "))
+	case 1:
+		return dates[0], nil
+	default:
+		return Date{}, fmt.Errorf("%w: %q matches %s",
+			ErrAmbiguousDate, s, strings.Join(layouts, ", "))
+	}
+}
+
+func containsDate(dates []Date, d Date) bool {
+	for _, date := range dates {
+		if date.Equal(d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p DateParser) parse(layout, s string) (Date, error) {
+	if p.Location == nil {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			return Date{}, err
+		}
+		return NewDateFromTime(t), nil
+	}
+
+	t, err := time.ParseInLocation(layout, s, p.Location)
+	if err != nil {
+		return Date{}, err
+	}
+	return NewDateFromTime(t.In(p.Location)), nil
+}
+
+// Format the date with a time.Format layout such as "01/02/2006"
+// any time in the layout is 00:00 UTC
+func (d Date) Format(layout string) string {
+	return d.ToTime().Format(layout)
+}
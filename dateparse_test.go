@@ -0,0 +1,102 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestParseDateLayout(t *testing.T) {
+	jul9 := schedule.NewDate(2022, 7, 9)
+
+	var tests = map[string]string{
+		"07/09/2022":                "01/02/2006",
+		"9 Jul 2022":                "2 Jan 2006",
+		"2022-07-09T23:30:00-05:00": time.RFC3339,
+		"2022-07-09":                "2006-01-02",
+	}
+	for input, layout := range tests {
+		d, err := schedule.ParseDateLayout(layout, input)
+		require.NoError(t, err, input)
+		assert.Equal(t, jul9, d, input)
+	}
+
+	_, err := schedule.ParseDateLayout("01/02/2006", "2022-07-09")
+	assert.ErrorIs(t, err, schedule.ErrUnparsableDate)
+
+	t.Run("Format", func(t *testing.T) {
+		assert.Equal(t, "07/09/2022", jul9.Format("01/02/2006"))
+		assert.Equal(t, "Saturday, 9 July 2022", jul9.Format("Monday, 2 January 2006"))
+	})
+}
+
+func TestParseDateInLocation(t *testing.T) {
+	var (
+		newYork, _ = time.LoadLocation("America/New_York")
+		tokyo      = time.FixedZone("Tokyo", 9*60*60)
+		input      = "2022-07-09T23:30:00-05:00"
+	)
+	if newYork == nil {
+		newYork = time.FixedZone("EDT", -4*60*60)
+	}
+
+	var tests = map[string]struct {
+		loc    *time.Location
+		expect string
+	}{
+		"UTC":      {time.UTC, "2022-07-10"},
+		"Tokyo":    {tokyo, "2022-07-10"},
+		"New York": {newYork, "2022-07-10"},
+		"-05:00":   {time.FixedZone("", -5*60*60), "2022-07-09"},
+	}
+	for name, tc := range tests {
+		d, err := schedule.ParseDateInLocation(time.RFC3339, input, tc.loc)
+		require.NoError(t, err, name)
+		assert.Equal(t, tc.expect, d.String(), name)
+	}
+
+	t.Run("no offset is read in location", func(t *testing.T) {
+		d, err := schedule.ParseDateInLocation("2006-01-02 15:04", "2022-07-09 23:30", tokyo)
+		require.NoError(t, err)
+		assert.Equal(t, "2022-07-09", d.String())
+	})
+}
+
+func TestDateParser(t *testing.T) {
+	parser := schedule.NewDateParser("2006-01-02", "01/02/2006", "02/01/2006", "2 Jan 2006")
+
+	t.Run("one layout matches", func(t *testing.T) {
+		for input, expect := range map[string]string{
+			"2022-07-09": "2022-07-09",
+			"07/29/2022": "2022-07-29",
+			"29/07/2022": "2022-07-29",
+			"9 Jul 2022": "2022-07-09",
+			"07/07/2022": "2022-07-07", // both layouts agree
+		} {
+			d, err := parser.Parse(input)
+			require.NoError(t, err, input)
+			assert.Equal(t, expect, d.String(), input)
+		}
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		_, err := parser.Parse("07/09/2022")
+		assert.ErrorIs(t, err, schedule.ErrAmbiguousDate)
+		assert.Contains(t, err.Error(), "01/02/2006, 02/01/2006")
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		_, err := parser.Parse("July 9th")
+		assert.ErrorIs(t, err, schedule.ErrUnparsableDate)
+	})
+
+	t.Run("In", func(t *testing.T) {
+		p := schedule.NewDateParser(time.RFC3339, "2006-01-02").In(time.UTC)
+		d, err := p.Parse("2022-07-09T23:30:00-05:00")
+		require.NoError(t, err)
+		assert.Equal(t, "2022-07-10", d.String())
+	})
+}
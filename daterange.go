@@ -1,14 +1,29 @@
 package schedule
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
 	"math"
+	"strings"
 )
 
+var _ json.Marshaler = (*DateRange)(nil)
+var _ json.Unmarshaler = (*DateRange)(nil)
+var _ encoding.TextMarshaler = (*DateRange)(nil)
+var _ encoding.TextUnmarshaler = (*DateRange)(nil)
+var _ sql.Scanner = (*DateRange)(nil)
+var _ driver.Valuer = (*DateRange)(nil)
+
 // DateRange represents a set of days
 // this set includes the From date and the Until date
 // from Jan1 until Jan1 is one day
 // from Jan1 until Jan2 is two days
 // when Until is nil it means forever
+// when From is zero and Until is set it is open start, every day up until Until
+// a zero From with a nil Until is the zero DateRange rather than every day
 type DateRange struct {
 	From  Date  `json:"from"`
 	Until *Date `json:"until,omitempty"`
@@ -19,6 +34,13 @@ func NewDateRange() DateRange {
 		From: Today(),
 	}
 }
+
+// NewDateRangeFromNow is today of p until forever, see TodayFrom
+func NewDateRangeFromNow(p NowProvider) DateRange {
+	return DateRange{
+		From: TodayFrom(p),
+	}
+}
 func NewDateRangeUntil(from Date, until *Date) DateRange {
 	return DateRange{
 		From:  from,
@@ -26,6 +48,11 @@ func NewDateRangeUntil(from Date, until *Date) DateRange {
 	}
 }
 
+// NewOpenStartDateRange is every day up until and including until
+func NewOpenStartDateRange(until Date) DateRange {
+	return DateRange{Until: &until}
+}
+
 func ZeroDateRange() DateRange {
 	return DateRange{
 		From:  *ZeroDate(),
@@ -41,8 +68,28 @@ func (dr DateRange) WithUntil(d Date) DateRange {
 	return dr
 }
 
-func (dr DateRange) Validate() error {
-	if dr.From == *ZeroDate() {
+// ValidateOption changes what DateRange.Validate and Schedule.Validate allow
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	requireFrom bool
+}
+
+// RequireFrom makes an open start, a zero From with an Until, invalid
+func RequireFrom() ValidateOption {
+	return func(o *validateOptions) { o.requireFrom = true }
+}
+
+// Validate returns ErrFromRequired when From is zero, unless Until is set
+// which is an open start range, use RequireFrom to require From anyway
+// ErrPastUntil is returned when Until is before From
+func (dr DateRange) Validate(opts ...ValidateOption) error {
+	var o validateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if dr.From.IsZero() && (o.requireFrom || dr.Until.IsZero()) {
 		return ErrFromRequired
 	}
 
@@ -61,6 +108,12 @@ func (dr DateRange) IsZero() bool {
 	return dr.From.IsZero() && dr.Until.IsZero()
 }
 
+// IsOpenStart is true when From is zero and Until is set
+// so the range is every day up until Until
+func (dr DateRange) IsOpenStart() bool {
+	return dr.From.IsZero() && !dr.Until.IsZero()
+}
+
 func (dr DateRange) ContainsDate(date Date) bool {
 	if dr.From.Before(date) || dr.From.Equal(date) {
 		return dr.Until == nil || dr.Until.After(date) || dr.Until.Equal(date)
@@ -136,6 +189,9 @@ func (dr DateRange) String() string {
 		from  = dr.From.String()
 		until = "forever"
 	)
+	if dr.From.IsZero() {
+		from = "forever"
+	}
 	if !dr.Until.IsZero() {
 		until = dr.Until.String()
 	}
@@ -149,7 +205,7 @@ func (dr DateRange) String() string {
 const InfDays = math.MaxInt32
 
 // DayCount is the number of days in range
-// when until is nil then the range is infinite
+// when until is nil or it is open start then the range is infinite
 // from today until today has one day in range
 // from today until tomorrow has two days in range
 func (dr DateRange) DayCount() int {
@@ -158,7 +214,7 @@ func (dr DateRange) DayCount() int {
 		return 0
 	}
 
-	if dr.Until == nil {
+	if dr.Until == nil || dr.IsOpenStart() {
 		return InfDays
 	}
 
@@ -174,3 +230,362 @@ func (dr DateRange) DayCount() int {
 func (dr DateRange) HasDays() bool {
 	return dr.DayCount() > 0
 }
+
+// CountWeekday is how many times w is in the range, worked out without
+// walking the dates, ErrInfiniteDateRange is returned when there is no end
+func (dr DateRange) CountWeekday(w Weekday) (int, error) {
+	if !w.IsValid() {
+		return 0, ErrInvalidWeekday
+	}
+	counts, err := dr.WeekdayCounts()
+	if err != nil {
+		return 0, err
+	}
+	return counts[w], nil
+}
+
+// WeekdayCounts is how many times each weekday is in the range
+// every weekday is in the map, even when the count is 0
+func (dr DateRange) WeekdayCounts() (map[Weekday]int, error) {
+	var n = dr.DayCount()
+	if n == InfDays {
+		return nil, ErrInfiniteDateRange
+	}
+
+	var (
+		counts = make(map[Weekday]int, 7)
+		start  = int(dr.From.Weekday())
+	)
+	for w := Sunday; w <= Saturday; w++ {
+		// days from the start until the first w, the rest of the weeks are full
+		first := (int(w) - start + 7) % 7
+		counts[w] = n / 7
+		if first < n%7 {
+			counts[w]++
+		}
+	}
+	return counts, nil
+}
+
+// Limit ends the range at limit when it is forever or ends after limit
+func (dr DateRange) Limit(limit Date) DateRange {
+	if dr.Until == nil || dr.Until.After(limit) {
+		dr.Until = &limit
+	}
+	return dr
+}
+
+// Days calls fn for each date in order, stopping early when fn returns false
+// it returns ErrInfiniteDateRange when Until is nil, use Limit first,
+// or when it is open start
+func (dr DateRange) Days(fn func(Date) bool) error {
+	if dr.IsZero() {
+		return nil
+	}
+	if dr.Until == nil || dr.IsOpenStart() {
+		return ErrInfiniteDateRange
+	}
+	for date := dr.From; !date.After(*dr.Until); date = date.Next() {
+		if !fn(date) {
+			break
+		}
+	}
+	return nil
+}
+
+// Dates lists every date in range, see Days
+func (dr DateRange) Dates() ([]Date, error) {
+	var dates = make([]Date, 0, dr.finiteDayCount())
+	err := dr.Days(func(d Date) bool {
+		dates = append(dates, d)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dates, nil
+}
+
+// SplitByWeek splits the range into weeks starting on weekStart
+// the first and last ranges are shorter when the range starts or ends mid week
+func (dr DateRange) SplitByWeek(weekStart Weekday) ([]DateRange, error) {
+	return dr.split(func(d Date) Date { return d.EndOfWeek(weekStart) })
+}
+
+// SplitByMonth splits the range into calendar months
+// the first and last ranges are shorter when the range starts or ends mid month
+func (dr DateRange) SplitByMonth() ([]DateRange, error) {
+	return dr.split(Date.EndOfMonth)
+}
+
+// SplitByN splits the range into ranges of n days, the last may be shorter
+// ErrInvalidSplitSize is returned when n is less than 1
+func (dr DateRange) SplitByN(n int) ([]DateRange, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("%w: got %d", ErrInvalidSplitSize, n)
+	}
+	// n larger than the range is one piece, and never overflows the date
+	if days := dr.finiteDayCount(); days > 0 && n > days {
+		n = days
+	}
+	return dr.split(func(d Date) Date { return Date{d.days + int64(n) - 1} })
+}
+
+// split cuts the range into pieces, end is the last date of the piece starting at a date
+func (dr DateRange) split(end func(Date) Date) ([]DateRange, error) {
+	var ranges = make([]DateRange, 0)
+	if dr.IsZero() {
+		return ranges, nil
+	}
+	if dr.Until == nil || dr.IsOpenStart() {
+		return nil, ErrInfiniteDateRange
+	}
+	for from := dr.From; !from.After(*dr.Until); {
+		until := end(from)
+		if until.After(*dr.Until) {
+			until = *dr.Until
+		}
+		ranges = append(ranges, NewDateRangeUntil(from, &until))
+		from = until.Next()
+	}
+	return ranges, nil
+}
+
+// finiteDayCount is DayCount without InfDays, for sizing slices
+func (dr DateRange) finiteDayCount() int {
+	if n := dr.DayCount(); n != InfDays {
+		return n
+	}
+	return 0
+}
+
+// openBound is the text form of a missing From or Until
+const openBound = ".."
+
+// emptyRange is the text and sql form of ZeroDateRange, as in Postgres
+const emptyRange = "empty"
+
+// isEmpty is true for ZeroDateRange, or any range ending at a zero Until,
+// which has no days and is encoded as "empty", or {"empty":true} in json,
+// so it decodes to ZeroDateRange
+// the zero DateRange with a nil Until is not, it has no encoding at all
+func (dr DateRange) isEmpty() bool {
+	return dr.Until != nil && dr.Until.IsZero()
+}
+
+// ParseDateRange takes any of the text forms of a DateRange
+//
+//	2026-01-01/2026-03-31       ISO 8601 interval, both dates included
+//	2026-01-01/..               until forever
+//	../2026-03-31               open start
+//	[2026-01-01,2026-04-01)     Postgres daterange, ( and ) exclude the date
+//	from 2026-01-01 until 2026-03-31, as made by String
+//
+// the Postgres "empty" range is ZeroDateRange
+func ParseDateRange(s string) (DateRange, error) {
+	s = strings.TrimSpace(s)
+	var (
+		dr  DateRange
+		err error
+	)
+	switch {
+	case strings.EqualFold(s, emptyRange):
+		return ZeroDateRange(), nil
+	case strings.HasPrefix(s, "from "):
+		dr, err = parseStringDateRange(s)
+	case strings.HasPrefix(s, "[") || strings.HasPrefix(s, "("):
+		dr, err = parsePostgresDateRange(s)
+	default:
+		dr, err = parseISODateRange(s)
+	}
+	if err != nil {
+		return DateRange{}, fmt.Errorf("%w: %s", ErrInvalidDateRangeString, s)
+	}
+	return dr, nil
+}
+
+// parseISODateRange takes "2026-01-01/2026-03-31" with ".." for an open end
+func parseISODateRange(s string) (DateRange, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 {
+		return DateRange{}, ErrInvalidDateRangeString
+	}
+	return dateRangeFromBounds(parts[0], parts[1], openBound)
+}
+
+// parseStringDateRange takes "from 2026-01-01 until 2026-03-31" with forever for an open end
+func parseStringDateRange(s string) (DateRange, error) {
+	var from, until string
+	if _, err := fmt.Sscanf(s, "from %s until %s", &from, &until); err != nil {
+		return DateRange{}, err
+	}
+	return dateRangeFromBounds(from, until, "forever")
+}
+
+// parsePostgresDateRange takes a daterange literal such as "[2026-01-01,2026-04-01)"
+// an empty bound or infinity is open
+func parsePostgresDateRange(s string) (DateRange, error) {
+	if len(s) < 3 || !strings.ContainsAny(s[len(s)-1:], ")]") {
+		return DateRange{}, ErrInvalidDateRangeString
+	}
+	var (
+		lowerInc = s[0] == '['
+		upperInc = s[len(s)-1] == ']'
+		parts    = strings.Split(s[1:len(s)-1], ",")
+	)
+	if len(parts) != 2 {
+		return DateRange{}, ErrInvalidDateRangeString
+	}
+	for i, p := range parts {
+		p = strings.Trim(strings.TrimSpace(p), `"`)
+		if p == "infinity" || p == "-infinity" {
+			p = ""
+		}
+		parts[i] = p
+	}
+	dr, err := dateRangeFromBounds(parts[0], parts[1], "")
+	if err != nil {
+		return DateRange{}, err
+	}
+	if !lowerInc && !dr.From.IsZero() {
+		dr.From = dr.From.Next()
+	}
+	if !upperInc && dr.Until != nil {
+		dr.Until = &Date{dr.Until.days - 1}
+	}
+	if dr.IsZero() {
+		return DateRange{}, ErrInvalidDateRangeString
+	}
+	return dr, nil
+}
+
+// dateRangeFromBounds parses from and until where open is a missing date
+// both can not be open because that would be the zero DateRange
+func dateRangeFromBounds(from, until, open string) (DateRange, error) {
+	var dr DateRange
+	if from == open && until == open {
+		return dr, ErrInvalidDateRangeString
+	}
+	if from != open {
+		d, err := ParseDateLayout(ymdFormat, from)
+		if err != nil {
+			return dr, err
+		}
+		dr.From = d
+	}
+	if until != open {
+		d, err := ParseDateLayout(ymdFormat, until)
+		if err != nil {
+			return dr, err
+		}
+		dr.Until = &d
+	}
+	return dr, nil
+}
+
+// MarshalText is the ISO 8601 interval "2026-01-01/2026-03-31"
+// with ".." in place of a zero From or a nil Until
+func (dr DateRange) MarshalText() (text []byte, err error) {
+	if dr.isEmpty() {
+		return []byte(emptyRange), nil
+	}
+	if dr.IsZero() {
+		return []byte{}, nil
+	}
+	var from, until = openBound, openBound
+	if !dr.From.IsZero() {
+		from = dr.From.String()
+	}
+	if dr.Until != nil {
+		until = dr.Until.String()
+	}
+	return []byte(from + "/" + until), nil
+}
+
+// UnmarshalText takes any form ParseDateRange does, empty text is left as is
+func (dr *DateRange) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	v, err := ParseDateRange(string(text))
+	if err != nil {
+		return err
+	}
+	*dr = v
+	return nil
+}
+
+// MarshalJSON leaves out from when it is open start and until when it is forever
+// the empty range, see isEmpty, is {"empty":true}
+func (dr DateRange) MarshalJSON() ([]byte, error) {
+	if dr.isEmpty() {
+		return json.Marshal(dateRangeJSON{Empty: true})
+	}
+	return json.Marshal(dateRangeJSON{
+		From:  dr.From.Date(),
+		Until: dr.Until,
+	})
+}
+
+// UnmarshalJSON takes the object MarshalJSON makes or any string ParseDateRange does
+func (dr *DateRange) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		return dr.UnmarshalText([]byte(s))
+	}
+
+	var v dateRangeJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	if v.Empty {
+		*dr = ZeroDateRange()
+		return nil
+	}
+	*dr = DateRange{Until: v.Until}
+	if v.From != nil {
+		dr.From = *v.From
+	}
+	return nil
+}
+
+type dateRangeJSON struct {
+	From  *Date `json:"from,omitempty"`
+	Until *Date `json:"until,omitempty"`
+	Empty bool  `json:"empty,omitempty"`
+}
+
+// Scan implements sql.Scanner for a Postgres daterange column
+// or a string in any form ParseDateRange takes
+func (dr *DateRange) Scan(src interface{}) error {
+	switch t := src.(type) {
+	case nil:
+		return nil
+	case string:
+		return dr.UnmarshalText([]byte(t))
+	case []byte:
+		return dr.UnmarshalText(t)
+	default:
+		return fmt.Errorf("DateRange.Scan requires a string or byte array got %T %v", src, src)
+	}
+}
+
+// Value is a Postgres daterange literal, which excludes the upper date
+// so from 2026-01-01 until 2026-03-31 is [2026-01-01,2026-04-01)
+// a range without days is "empty" and the zero DateRange with a nil Until is NULL
+func (dr DateRange) Value() (driver.Value, error) {
+	if dr.IsZero() && dr.Until == nil {
+		return nil, nil
+	}
+	if !dr.HasDays() {
+		return emptyRange, nil
+	}
+	var lower, upper string
+	if !dr.From.IsZero() {
+		lower = dr.From.String()
+	}
+	if dr.Until != nil {
+		upper = dr.Until.Next().String()
+	}
+	return "[" + lower + "," + upper + ")", nil
+}
@@ -1,6 +1,8 @@
 package schedule_test
 
 import (
+	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -168,21 +170,25 @@ func TestDateRange_Validate(t *testing.T) {
 	var dr schedule.DateRange
 
 	validateTests := map[string]struct {
-		dr  schedule.DateRange
-		err error
+		dr   schedule.DateRange
+		err  error
+		opts []schedule.ValidateOption
 	}{
 		// valid cases
-		"no until":    {dr.WithFrom(schedule.Today()), nil},
-		"zero length": {dr.WithFrom(schedule.Today()).WithUntil(schedule.Today()), nil},
+		"no until":    {dr.WithFrom(schedule.Today()), nil, nil},
+		"zero length": {dr.WithFrom(schedule.Today()).WithUntil(schedule.Today()), nil, nil},
 		"one month": {
 			dr: dr.WithFrom(*schedule.ParseDate("2022-02-01")).
 				WithUntil(*schedule.ParseDate("2022-03-01")),
 			err: nil,
 		},
+		"open start": {dr.WithUntil(schedule.Today()), nil, nil},
 
 		// error cases
-		"empty":   {dr, schedule.ErrFromRequired},
-		"no from": {dr.WithUntil(schedule.Today()), schedule.ErrFromRequired},
+		"empty":              {dr, schedule.ErrFromRequired, nil},
+		"empty require from": {dr, schedule.ErrFromRequired, []schedule.ValidateOption{schedule.RequireFrom()}},
+		"no from":            {dr.WithUntil(schedule.Today()), schedule.ErrFromRequired, []schedule.ValidateOption{schedule.RequireFrom()}},
+		"zero until":         {schedule.ZeroDateRange(), schedule.ErrFromRequired, nil},
 		"past until": {
 			dr: dr.WithFrom(*schedule.ParseDate("2022-02-01")).
 				WithUntil(*schedule.ParseDate("2022-01-01")),
@@ -191,7 +197,7 @@ func TestDateRange_Validate(t *testing.T) {
 	}
 	for name, tc := range validateTests {
 		t.Run(name, func(t *testing.T) {
-			assert.Equal(t, tc.err, tc.dr.Validate())
+			assert.Equal(t, tc.err, tc.dr.Validate(tc.opts...))
 		})
 	}
 }
@@ -289,3 +295,362 @@ func TestDateRange_Exceeds(t *testing.T) {
 		})
 	}
 }
+
+func TestDateRange_Days(t *testing.T) {
+	var (
+		oct30 = schedule.NewDate(2026, 10, 30) // Friday
+		nov3  = schedule.NewDate(2026, 11, 3)
+		dr    = schedule.NewDateRangeUntil(oct30, &nov3)
+		open  = schedule.NewDateRangeUntil(oct30, nil)
+	)
+
+	t.Run("Days", func(t *testing.T) {
+		var got []string
+		require.NoError(t, dr.Days(func(d schedule.Date) bool {
+			got = append(got, d.String())
+			return len(got) < 3
+		}))
+		assert.Equal(t, []string{"2026-10-30", "2026-10-31", "2026-11-01"}, got)
+
+		dates, err := dr.Dates()
+		require.NoError(t, err)
+		assert.Len(t, dates, dr.DayCount())
+		assert.Equal(t, nov3, dates[len(dates)-1])
+
+		dates, err = dr.WithUntil(oct30.AddDate(0, 0, -1)).Dates()
+		require.NoError(t, err)
+		assert.Empty(t, dates)
+	})
+
+	t.Run("infinite", func(t *testing.T) {
+		assert.ErrorIs(t, open.Days(func(schedule.Date) bool { return true }), schedule.ErrInfiniteDateRange)
+		_, err := open.Dates()
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+		_, err = open.SplitByWeek(schedule.Monday)
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+		_, err = open.SplitByMonth()
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+		_, err = open.SplitByN(7)
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+
+		dates, err := open.Limit(nov3).Dates()
+		require.NoError(t, err)
+		assert.Len(t, dates, 5)
+		assert.Equal(t, dr, dr.Limit(nov3.Next()))
+	})
+
+	t.Run("split", func(t *testing.T) {
+		var (
+			jan15 = schedule.NewDate(2026, 1, 15) // Thursday
+			mar3  = schedule.NewDate(2026, 3, 3)
+			dr    = schedule.NewDateRangeUntil(jan15, &mar3)
+		)
+		var tests = map[string]struct {
+			split  func() ([]schedule.DateRange, error)
+			expect []string
+		}{
+			"month": {dr.SplitByMonth, []string{
+				"from 2026-01-15 until 2026-01-31",
+				"from 2026-02-01 until 2026-02-28",
+				"from 2026-03-01 until 2026-03-03",
+			}},
+			"week": {
+				func() ([]schedule.DateRange, error) {
+					return dr.WithUntil(schedule.NewDate(2026, 1, 27)).SplitByWeek(schedule.Monday)
+				},
+				[]string{
+					"from 2026-01-15 until 2026-01-18",
+					"from 2026-01-19 until 2026-01-25",
+					"from 2026-01-26 until 2026-01-27",
+				}},
+			"20 days": {
+				func() ([]schedule.DateRange, error) { return dr.SplitByN(20) },
+				[]string{
+					"from 2026-01-15 until 2026-02-03",
+					"from 2026-02-04 until 2026-02-23",
+					"from 2026-02-24 until 2026-03-03",
+				}},
+			"single day": {
+				func() ([]schedule.DateRange, error) { return dr.WithUntil(jan15).SplitByN(1) },
+				[]string{"from 2026-01-15 until 2026-01-15"}},
+			"larger than range": {
+				func() ([]schedule.DateRange, error) { return dr.SplitByN(math.MaxInt64) },
+				[]string{"from 2026-01-15 until 2026-03-03"}},
+		}
+		for name, tc := range tests {
+			ranges, err := tc.split()
+			require.NoError(t, err, name)
+			var got []string
+			for _, r := range ranges {
+				got = append(got, r.String())
+			}
+			assert.Equal(t, tc.expect, got, name)
+		}
+
+		_, err := dr.SplitByN(0)
+		assert.ErrorIs(t, err, schedule.ErrInvalidSplitSize)
+	})
+}
+
+func TestDateRange_openStart(t *testing.T) {
+	var (
+		jan1  = schedule.NewDate(2026, 1, 1)
+		jan31 = schedule.NewDate(2026, 1, 31)
+		dec31 = schedule.NewDate(2026, 12, 31)
+		past  = schedule.NewDate(1900, 1, 1)
+
+		legacy = schedule.NewOpenStartDateRange(dec31)
+		jan    = schedule.NewDateRangeUntil(jan1, &jan31)
+		next   = schedule.NewDateRangeUntil(dec31.Next(), nil)
+	)
+
+	assert.True(t, legacy.IsOpenStart())
+	assert.False(t, jan.IsOpenStart())
+	assert.NoError(t, legacy.Validate())
+	assert.ErrorIs(t, legacy.Validate(schedule.RequireFrom()), schedule.ErrFromRequired)
+	assert.Equal(t, "from forever until 2026-12-31", legacy.String())
+	assert.Equal(t, schedule.InfDays, legacy.DayCount())
+
+	assert.True(t, legacy.ContainsDate(past))
+	assert.True(t, legacy.ContainsDate(dec31))
+	assert.False(t, legacy.ContainsDate(dec31.Next()))
+
+	assert.True(t, legacy.Overlaps(jan))
+	assert.True(t, jan.Overlaps(legacy))
+	assert.False(t, legacy.Overlaps(next))
+	assert.True(t, legacy.Adjacent(next))
+	assert.True(t, jan.Exceeds(schedule.NewOpenStartDateRange(jan1)))
+	assert.False(t, legacy.Exceeds(schedule.NewOpenStartDateRange(dec31)))
+
+	assert.Equal(t, jan, legacy.Merge(jan))
+	assert.Equal(t, schedule.NewOpenStartDateRange(jan31), legacy.Merge(schedule.NewOpenStartDateRange(jan31)))
+	assert.True(t, legacy.Merge(next).IsZero())
+
+	assert.Equal(t,
+		"from forever until 2025-12-31, from 2026-02-01 until 2026-12-31",
+		legacy.Difference(jan).String())
+	assert.True(t, legacy.Union(jan).Equal(schedule.NewDateRangeSet(legacy)))
+
+	_, err := legacy.Dates()
+	assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+	_, err = legacy.SplitByMonth()
+	assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(legacy)
+		require.NoError(t, err)
+		assert.Equal(t, `{"until":"2026-12-31"}`, string(b))
+
+		b, err = json.Marshal(jan)
+		require.NoError(t, err)
+		assert.Equal(t, `{"from":"2026-01-01","until":"2026-01-31"}`, string(b))
+
+		var dr schedule.DateRange
+		require.NoError(t, json.Unmarshal([]byte(`{"until":"2026-12-31"}`), &dr))
+		assert.Equal(t, legacy, dr)
+	})
+}
+
+func TestDateRange_encoding(t *testing.T) {
+	var (
+		jan1  = schedule.NewDate(2026, 1, 1)
+		mar31 = schedule.NewDate(2026, 3, 31)
+
+		q1     = schedule.NewDateRangeUntil(jan1, &mar31)
+		open   = schedule.NewDateRangeUntil(jan1, nil)
+		legacy = schedule.NewOpenStartDateRange(mar31)
+	)
+
+	t.Run("ParseDateRange", func(t *testing.T) {
+		var tests = map[string]struct {
+			in     string
+			expect schedule.DateRange
+		}{
+			"iso":                {"2026-01-01/2026-03-31", q1},
+			"iso open end":       {"2026-01-01/..", open},
+			"iso open start":     {"../2026-03-31", legacy},
+			"pg exclusive upper": {"[2026-01-01,2026-04-01)", q1},
+			"pg inclusive upper": {"[2026-01-01,2026-03-31]", q1},
+			"pg exclusive lower": {"(2025-12-31,2026-04-01)", q1},
+			"pg quoted":          {`["2026-01-01","2026-04-01")`, q1},
+			"pg unbounded upper": {"[2026-01-01,)", open},
+			"pg infinity":        {"[2026-01-01,infinity)", open},
+			"pg unbounded lower": {"(,2026-04-01)", legacy},
+			"pg empty":           {"empty", schedule.ZeroDateRange()},
+			"string":             {"from 2026-01-01 until 2026-03-31", q1},
+			"string forever":     {"from 2026-01-01 until forever", open},
+			"string open start":  {"from forever until 2026-03-31", legacy},
+		}
+		for name, tc := range tests {
+			dr, err := schedule.ParseDateRange(tc.in)
+			require.NoError(t, err, name)
+			assert.Equal(t, tc.expect, dr, name)
+		}
+
+		for _, s := range []string{
+			"", "2026-01-01", "2026-01-01/2026-13-01", "../..", "2026-01-01/2026-02-01/2026-03-01",
+			"[2026-01-01,2026-04-01", "[,)", "[2026-01-01]", "from 2026-01-01", "from forever until forever",
+		} {
+			_, err := schedule.ParseDateRange(s)
+			assert.ErrorIs(t, err, schedule.ErrInvalidDateRangeString, s)
+		}
+	})
+
+	t.Run("String round trip", func(t *testing.T) {
+		for _, dr := range []schedule.DateRange{q1, open, legacy} {
+			parsed, err := schedule.ParseDateRange(dr.String())
+			require.NoError(t, err, dr.String())
+			assert.Equal(t, dr, parsed)
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		var tests = map[string]struct {
+			dr     schedule.DateRange
+			expect string
+		}{
+			"closed":     {q1, "2026-01-01/2026-03-31"},
+			"open end":   {open, "2026-01-01/.."},
+			"open start": {legacy, "../2026-03-31"},
+			"zero":       {schedule.DateRange{}, ""},
+			"empty":      {schedule.ZeroDateRange(), "empty"},
+		}
+		for name, tc := range tests {
+			b, err := tc.dr.MarshalText()
+			require.NoError(t, err, name)
+			assert.Equal(t, tc.expect, string(b), name)
+
+			var dr schedule.DateRange
+			require.NoError(t, dr.UnmarshalText(b), name)
+			assert.Equal(t, tc.dr, dr, name)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(q1)
+		require.NoError(t, err)
+		assert.Equal(t, `{"from":"2026-01-01","until":"2026-03-31"}`, string(b))
+
+		for _, in := range []string{
+			`{"from":"2026-01-01","until":"2026-03-31"}`,
+			`"2026-01-01/2026-03-31"`,
+			`"[2026-01-01,2026-04-01)"`,
+		} {
+			var dr schedule.DateRange
+			require.NoError(t, json.Unmarshal([]byte(in), &dr), in)
+			assert.Equal(t, q1, dr, in)
+		}
+
+		for expect, in := range map[string]schedule.DateRange{
+			`{}`:             {},
+			`{"empty":true}`: schedule.ZeroDateRange(),
+		} {
+			b, err := json.Marshal(in)
+			require.NoError(t, err, expect)
+			assert.Equal(t, expect, string(b))
+			var dr schedule.DateRange
+			require.NoError(t, json.Unmarshal(b, &dr), expect)
+			assert.Equal(t, in, dr, expect)
+		}
+
+		var dr schedule.DateRange
+		assert.Error(t, json.Unmarshal([]byte(`"nope"`), &dr))
+		assert.Error(t, json.Unmarshal([]byte(`{"from":"nope"}`), &dr))
+		require.NoError(t, json.Unmarshal([]byte(`null`), &dr))
+		assert.True(t, dr.IsZero())
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		var tests = map[string]struct {
+			dr     schedule.DateRange
+			expect interface{}
+		}{
+			"closed":     {q1, "[2026-01-01,2026-04-01)"},
+			"single day": {q1.WithUntil(jan1), "[2026-01-01,2026-01-02)"},
+			"open end":   {open, "[2026-01-01,)"},
+			"open start": {legacy, "[,2026-04-01)"},
+			"no days":    {q1.WithUntil(jan1.AddDate(0, 0, -1)), "empty"},
+			"zero":       {schedule.DateRange{}, nil},
+			"empty":      {schedule.ZeroDateRange(), "empty"},
+		}
+		for name, tc := range tests {
+			v, err := tc.dr.Value()
+			require.NoError(t, err, name)
+			assert.Equal(t, tc.expect, v, name)
+
+			if s, ok := v.(string); ok && tc.dr.HasDays() {
+				var dr schedule.DateRange
+				require.NoError(t, dr.Scan([]byte(s)), name)
+				assert.Equal(t, tc.dr, dr, name)
+			}
+		}
+
+		// the empty range and NULL both round trip
+		var dr schedule.DateRange
+		require.NoError(t, dr.Scan("empty"))
+		assert.Equal(t, schedule.ZeroDateRange(), dr)
+		v, err := dr.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "empty", v)
+		dr = schedule.DateRange{}
+		require.NoError(t, dr.Scan(nil))
+		v, err = dr.Value()
+		require.NoError(t, err)
+		assert.Nil(t, v)
+		assert.Error(t, dr.Scan(42))
+	})
+}
+
+func TestDateRange_WeekdayCounts(t *testing.T) {
+	var oct1 = schedule.NewDate(2026, 10, 1) // Thursday
+
+	t.Run("matches iterating", func(t *testing.T) {
+		for from := oct1; from.Before(oct1.AddDate(0, 0, 7)); from = from.Next() {
+			for n := 0; n < 30; n++ {
+				dr := schedule.NewDateRangeUntil(from, from.AddDate(0, 0, n-1).Pointer())
+				expect := make(map[schedule.Weekday]int)
+				for w := schedule.Sunday; w <= schedule.Saturday; w++ {
+					expect[w] = 0
+				}
+				dates, err := dr.Dates()
+				require.NoError(t, err)
+				for _, d := range dates {
+					expect[d.Weekday()]++
+				}
+
+				counts, err := dr.WeekdayCounts()
+				require.NoError(t, err)
+				if !assert.Equal(t, expect, counts, dr.String()) {
+					return
+				}
+			}
+		}
+	})
+
+	t.Run("CountWeekday", func(t *testing.T) {
+		var (
+			dec31 = schedule.NewDate(2026, 12, 31)
+			q4    = schedule.NewDateRangeUntil(oct1, &dec31)
+		)
+		mondays, err := q4.CountWeekday(schedule.Monday)
+		require.NoError(t, err)
+		assert.Equal(t, 13, mondays)
+		thursdays, err := q4.CountWeekday(schedule.Thursday)
+		require.NoError(t, err)
+		assert.Equal(t, 14, thursdays)
+
+		_, err = q4.CountWeekday(schedule.Weekday(7))
+		assert.ErrorIs(t, err, schedule.ErrInvalidWeekday)
+
+		n, err := schedule.ZeroDateRange().CountWeekday(schedule.Monday)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("infinite", func(t *testing.T) {
+		_, err := schedule.NewDateRangeUntil(oct1, nil).CountWeekday(schedule.Monday)
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+		_, err = schedule.NewOpenStartDateRange(oct1).WeekdayCounts()
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+	})
+}
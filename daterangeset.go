@@ -0,0 +1,210 @@
+package schedule
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// DateRangeSet is a normalized set of dates made of DateRange's
+// the ranges are sorted by From, have days and never overlap or touch,
+// so only the first range can be open start and only the last can go on forever
+type DateRangeSet struct {
+	ranges []DateRange
+}
+
+// NewDateRangeSet normalizes the ranges, those which overlap or are adjacent
+// are joined into one and those without days are dropped
+func NewDateRangeSet(ranges ...DateRange) DateRangeSet {
+	var sorted = make([]DateRange, 0, len(ranges))
+	for _, dr := range ranges {
+		if dr.HasDays() {
+			sorted = append(sorted, dr)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].From.Before(sorted[j].From)
+	})
+
+	var merged = make([]DateRange, 0, len(sorted))
+	for _, dr := range sorted {
+		n := len(merged)
+		if n == 0 || !merged[n-1].joins(dr) {
+			merged = append(merged, dr)
+			continue
+		}
+		merged[n-1].Until = maxUntil(merged[n-1].Until, dr.Until)
+	}
+	return DateRangeSet{ranges: merged}
+}
+
+// Ranges is a copy of the normalized ranges
+func (s DateRangeSet) Ranges() []DateRange {
+	return append([]DateRange{}, s.ranges...)
+}
+
+func (s DateRangeSet) Len() int       { return len(s.ranges) }
+func (s DateRangeSet) IsEmpty() bool  { return len(s.ranges) == 0 }
+func (s DateRangeSet) String() string { return strings.Join(s.strings(), ", ") }
+
+func (s DateRangeSet) Equal(s2 DateRangeSet) bool {
+	if len(s.ranges) != len(s2.ranges) {
+		return false
+	}
+	for i := range s.ranges {
+		if !s.ranges[i].Equal(s2.ranges[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DayCount is the number of dates in the set, InfDays when it goes on forever
+func (s DateRangeSet) DayCount() int {
+	var n int
+	for _, dr := range s.ranges {
+		c := dr.DayCount()
+		if c == InfDays {
+			return InfDays
+		}
+		n += c
+	}
+	return n
+}
+
+// ContainsDate uses a binary search so it is O(log n)
+func (s DateRangeSet) ContainsDate(d Date) bool {
+	i := sort.Search(len(s.ranges), func(i int) bool {
+		return s.ranges[i].From.After(d)
+	})
+	return i > 0 && s.ranges[i-1].ContainsDate(d)
+}
+
+// Union is every date in either set
+func (s DateRangeSet) Union(s2 DateRangeSet) DateRangeSet {
+	return NewDateRangeSet(append(s.Ranges(), s2.ranges...)...)
+}
+
+// Intersect is every date in both sets
+func (s DateRangeSet) Intersect(s2 DateRangeSet) DateRangeSet {
+	var (
+		ranges = make([]DateRange, 0)
+		a, b   = s.ranges, s2.ranges
+	)
+	for len(a) > 0 && len(b) > 0 {
+		if m := a[0].Merge(b[0]); m.HasDays() {
+			ranges = append(ranges, m)
+		}
+		// move past whichever range ends first, forever ends last
+		if MinDate(a[0].Until, b[0].Until) == a[0].Until {
+			a = a[1:]
+		} else {
+			b = b[1:]
+		}
+	}
+	return NewDateRangeSet(ranges...)
+}
+
+// Difference is every date in s which is not in s2
+func (s DateRangeSet) Difference(s2 DateRangeSet) DateRangeSet {
+	var ranges = s.Ranges()
+	for _, cut := range s2.ranges {
+		var remaining = make([]DateRange, 0, len(ranges)+1)
+		for _, dr := range ranges {
+			remaining = append(remaining, dr.difference(cut)...)
+		}
+		ranges = remaining
+	}
+	return NewDateRangeSet(ranges...)
+}
+
+// Gaps are the dates between the ranges of the set
+// the dates before the first range and after the last are not gaps
+func (s DateRangeSet) Gaps() DateRangeSet {
+	var gaps = make([]DateRange, 0)
+	for i := 1; i < len(s.ranges); i++ {
+		var (
+			from  = s.ranges[i-1].Until.Next()
+			until = Date{s.ranges[i].From.days - 1}
+		)
+		gaps = append(gaps, NewDateRangeUntil(from, &until))
+	}
+	return DateRangeSet{ranges: gaps}
+}
+
+func (s DateRangeSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Ranges())
+}
+
+// UnmarshalJSON takes a list of DateRange's and normalizes them
+func (s *DateRangeSet) UnmarshalJSON(b []byte) error {
+	var ranges []DateRange
+	if err := json.Unmarshal(b, &ranges); err != nil {
+		return err
+	}
+	*s = NewDateRangeSet(ranges...)
+	return nil
+}
+
+func (s DateRangeSet) strings() []string {
+	var ss = make([]string, len(s.ranges))
+	for i, dr := range s.ranges {
+		ss[i] = dr.String()
+	}
+	return ss
+}
+
+// Adjacent is true when one range ends the day before the other starts
+// so together they cover every date without overlapping
+func (dr DateRange) Adjacent(dr2 DateRange) bool {
+	if !dr.HasDays() || !dr2.HasDays() {
+		return false
+	}
+	return dr.Until != nil && dr.Until.Next().Equal(dr2.From) ||
+		dr2.Until != nil && dr2.Until.Next().Equal(dr.From)
+}
+
+// Union is every date in either range, which is a single range
+// when they overlap or are adjacent and two ranges when they are not
+func (dr DateRange) Union(dr2 DateRange) DateRangeSet {
+	return NewDateRangeSet(dr, dr2)
+}
+
+// Difference is every date in dr which is not in dr2
+// such as a vacation carved out of an employment period
+//
+//	Jan1-Dec31 difference Jul1-Jul14 = Jan1-Jun30, Jul15-Dec31
+func (dr DateRange) Difference(dr2 DateRange) DateRangeSet {
+	if !dr2.HasDays() {
+		return NewDateRangeSet(dr)
+	}
+	return NewDateRangeSet(dr.difference(dr2)...)
+}
+
+// difference is dr without dr2, dr2 must have days
+func (dr DateRange) difference(dr2 DateRange) []DateRange {
+	if !dr.Overlaps(dr2) {
+		return []DateRange{dr}
+	}
+	var ranges = make([]DateRange, 0, 2)
+	if dr.From.Before(dr2.From) {
+		ranges = append(ranges, dr.WithUntil(Date{dr2.From.days - 1}))
+	}
+	if dr2.Until != nil && (dr.Until == nil || dr.Until.After(*dr2.Until)) {
+		ranges = append(ranges, dr.WithFrom(dr2.Until.Next()))
+	}
+	return ranges
+}
+
+// joins is true when dr2, which does not start before dr, overlaps or is adjacent
+func (dr DateRange) joins(dr2 DateRange) bool {
+	return dr.Until == nil || !dr2.From.After(dr.Until.Next())
+}
+
+// maxUntil is the later of a and b where nil is forever
+func maxUntil(a, b *Date) *Date {
+	if a == nil || b == nil {
+		return nil
+	}
+	return MaxDate(a, b)
+}
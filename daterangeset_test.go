@@ -0,0 +1,135 @@
+package schedule_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestDateRangeSet(t *testing.T) {
+	var (
+		day = func(m, d int) schedule.Date { return schedule.NewDate(2026, time.Month(m), d) }
+		dr  = func(m1, d1, m2, d2 int) schedule.DateRange {
+			return schedule.NewDateRangeUntil(day(m1, d1), day(m2, d2).Pointer())
+		}
+		from = func(m, d int) schedule.DateRange { return schedule.NewDateRangeUntil(day(m, d), nil) }
+		set  = schedule.NewDateRangeSet
+	)
+
+	t.Run("normalize", func(t *testing.T) {
+		var tests = map[string]struct {
+			in     []schedule.DateRange
+			expect string
+		}{
+			"empty":           {nil, ""},
+			"no days":         {[]schedule.DateRange{dr(1, 5, 1, 4), schedule.ZeroDateRange()}, ""},
+			"sorted":          {[]schedule.DateRange{dr(3, 1, 3, 5), dr(1, 1, 1, 5)}, "from 2026-01-01 until 2026-01-05, from 2026-03-01 until 2026-03-05"},
+			"overlapping":     {[]schedule.DateRange{dr(1, 1, 1, 10), dr(1, 5, 1, 20), dr(1, 2, 1, 3)}, "from 2026-01-01 until 2026-01-20"},
+			"adjacent":        {[]schedule.DateRange{dr(2, 1, 2, 28), dr(1, 1, 1, 31)}, "from 2026-01-01 until 2026-02-28"},
+			"gap of one":      {[]schedule.DateRange{dr(1, 1, 1, 5), dr(1, 7, 1, 9)}, "from 2026-01-01 until 2026-01-05, from 2026-01-07 until 2026-01-09"},
+			"forever":         {[]schedule.DateRange{dr(1, 1, 1, 5), from(1, 3), dr(6, 1, 6, 5)}, "from 2026-01-01 until forever"},
+			"ends at forever": {[]schedule.DateRange{from(6, 1), dr(5, 1, 5, 31)}, "from 2026-05-01 until forever"},
+		}
+		for name, tc := range tests {
+			assert.Equal(t, tc.expect, set(tc.in...).String(), name)
+		}
+	})
+
+	t.Run("Union Intersect Difference", func(t *testing.T) {
+		var (
+			a = set(dr(1, 1, 1, 10), dr(2, 1, 2, 10), from(3, 1))
+			b = set(dr(1, 5, 2, 3), dr(3, 10, 3, 20))
+		)
+		assert.Equal(t,
+			"from 2026-01-01 until 2026-02-10, from 2026-03-01 until forever",
+			a.Union(b).String())
+		assert.Equal(t,
+			"from 2026-01-05 until 2026-01-10, from 2026-02-01 until 2026-02-03, from 2026-03-10 until 2026-03-20",
+			a.Intersect(b).String())
+		assert.True(t, a.Intersect(b).Equal(b.Intersect(a)))
+		assert.Equal(t,
+			"from 2026-01-01 until 2026-01-04, from 2026-02-04 until 2026-02-10, from 2026-03-01 until 2026-03-09, from 2026-03-21 until forever",
+			a.Difference(b).String())
+		assert.Equal(t,
+			"from 2026-01-11 until 2026-01-31",
+			b.Difference(a).String())
+		assert.True(t, a.Difference(a).IsEmpty())
+		assert.True(t, a.Union(set()).Equal(a))
+	})
+
+	t.Run("Gaps ContainsDate DayCount", func(t *testing.T) {
+		var s = set(dr(1, 1, 1, 10), dr(1, 12, 1, 12), dr(2, 1, 2, 10))
+		assert.Equal(t,
+			"from 2026-01-11 until 2026-01-11, from 2026-01-13 until 2026-01-31",
+			s.Gaps().String())
+		assert.Equal(t, 21, s.DayCount())
+		assert.Equal(t, schedule.InfDays, s.Union(set(from(3, 1))).DayCount())
+
+		for _, d := range []schedule.Date{day(1, 1), day(1, 10), day(1, 12), day(2, 5)} {
+			assert.True(t, s.ContainsDate(d), d.String())
+		}
+		for _, d := range []schedule.Date{day(12, 31).AddDate(-1, 0, 0), day(1, 11), day(1, 13), day(2, 11)} {
+			assert.False(t, s.ContainsDate(d), d.String())
+		}
+		assert.True(t, set(from(3, 1)).ContainsDate(day(12, 31).AddDate(10, 0, 0)))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var s = set(dr(1, 1, 1, 10), from(3, 1))
+		b, err := json.Marshal(s)
+		require.NoError(t, err)
+		assert.Equal(t, `[{"from":"2026-01-01","until":"2026-01-10"},{"from":"2026-03-01"}]`, string(b))
+
+		var s2 schedule.DateRangeSet
+		require.NoError(t, json.Unmarshal([]byte(`[{"from":"2026-03-01"},{"from":"2026-01-01","until":"2026-01-10"},{"from":"2026-01-11","until":"2026-01-12"}]`), &s2))
+		assert.Equal(t, "from 2026-01-01 until 2026-01-12, from 2026-03-01 until forever", s2.String())
+	})
+}
+
+func TestDateRange_setAlgebra(t *testing.T) {
+	var (
+		jan1  = schedule.NewDate(2026, 1, 1)
+		jan31 = schedule.NewDate(2026, 1, 31)
+		feb1  = schedule.NewDate(2026, 2, 1)
+		feb28 = schedule.NewDate(2026, 2, 28)
+		dec31 = schedule.NewDate(2026, 12, 31)
+
+		jan      = schedule.NewDateRangeUntil(jan1, &jan31)
+		feb      = schedule.NewDateRangeUntil(feb1, &feb28)
+		year     = schedule.NewDateRangeUntil(jan1, &dec31)
+		fromFeb  = schedule.NewDateRangeUntil(feb1, nil)
+		vacation = schedule.NewDateRangeUntil(schedule.NewDate(2026, 7, 1), schedule.NewDate(2026, 7, 14).Pointer())
+	)
+
+	t.Run("Adjacent", func(t *testing.T) {
+		assert.True(t, jan.Adjacent(feb))
+		assert.True(t, feb.Adjacent(jan))
+		assert.True(t, jan.Adjacent(fromFeb))
+		assert.False(t, jan.Adjacent(jan))
+		assert.False(t, jan.Adjacent(year))
+		assert.False(t, jan.Adjacent(feb.WithFrom(feb1.Next())))
+	})
+
+	t.Run("Union", func(t *testing.T) {
+		assert.Equal(t, "from 2026-01-01 until 2026-02-28", jan.Union(feb).String())
+		assert.Equal(t, "from 2026-01-01 until forever", fromFeb.Union(jan).String())
+		assert.Equal(t, 2, jan.Union(vacation).Len())
+	})
+
+	t.Run("Difference", func(t *testing.T) {
+		assert.Equal(t,
+			"from 2026-01-01 until 2026-06-30, from 2026-07-15 until 2026-12-31",
+			year.Difference(vacation).String())
+		assert.Equal(t,
+			"from 2026-02-01 until 2026-06-30, from 2026-07-15 until forever",
+			fromFeb.Difference(vacation).String())
+		assert.Equal(t, "from 2026-01-01 until 2026-01-31", year.Difference(fromFeb).String())
+		assert.Equal(t, "from 2026-01-01 until 2026-01-31", jan.Difference(feb).String())
+		assert.True(t, jan.Difference(year).IsEmpty())
+		assert.Equal(t, "from 2027-01-01 until forever", fromFeb.Difference(year).String())
+	})
+}
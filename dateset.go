@@ -0,0 +1,279 @@
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// dateSetWords is enough words for one bit per day of a leap year
+const dateSetWords = (366 + 63) / 64
+
+// DateSet is a set of dates with one bit for each day of a year
+// so exclusion and holiday lists stay small and Contains is O(1)
+// the zero value is an empty set ready to use, zero dates are ignored
+type DateSet struct {
+	years map[int]*[dateSetWords]uint64
+}
+
+func NewDateSet(dates ...Date) DateSet {
+	var s DateSet
+	s.Add(dates...)
+	return s
+}
+
+// NewDateSetFromRanges has every date of each range
+// ErrInfiniteDateRange is returned when any range has no end
+func NewDateSetFromRanges(ranges ...DateRange) (DateSet, error) {
+	var s DateSet
+	for _, dr := range ranges {
+		if err := s.AddRange(dr); err != nil {
+			return DateSet{}, err
+		}
+	}
+	return s, nil
+}
+
+// dateSetIndex is the year and day of the year, starting at 0, of d
+func dateSetIndex(d Date) (year, day int) {
+	year = d.Year()
+	return year, int(d.days - NewDate(year, 1, 1).days)
+}
+
+func (s *DateSet) Add(dates ...Date) {
+	for _, d := range dates {
+		if d.IsZero() {
+			continue
+		}
+		if s.years == nil {
+			s.years = make(map[int]*[dateSetWords]uint64)
+		}
+		year, day := dateSetIndex(d)
+		words := s.years[year]
+		if words == nil {
+			words = new([dateSetWords]uint64)
+			s.years[year] = words
+		}
+		words[day/64] |= 1 << (day % 64)
+	}
+}
+
+// AddRange adds every date of dr, ErrInfiniteDateRange is returned when it has no end
+func (s *DateSet) AddRange(dr DateRange) error {
+	return dr.Days(func(d Date) bool {
+		s.Add(d)
+		return true
+	})
+}
+
+func (s *DateSet) Remove(dates ...Date) {
+	for _, d := range dates {
+		if d.IsZero() {
+			continue
+		}
+		year, day := dateSetIndex(d)
+		words := s.years[year]
+		if words == nil {
+			continue
+		}
+		words[day/64] &^= 1 << (day % 64)
+		if *words == [dateSetWords]uint64{} {
+			delete(s.years, year)
+		}
+	}
+}
+
+func (s DateSet) Contains(d Date) bool {
+	if d.IsZero() {
+		return false
+	}
+	year, day := dateSetIndex(d)
+	words := s.years[year]
+	return words != nil && words[day/64]&(1<<(day%64)) != 0
+}
+
+// Len is the number of dates in the set
+func (s DateSet) Len() int {
+	var n int
+	for _, words := range s.years {
+		for _, w := range words {
+			n += bits.OnesCount64(w)
+		}
+	}
+	return n
+}
+
+func (s DateSet) IsEmpty() bool { return len(s.years) == 0 }
+
+func (s DateSet) Equal(s2 DateSet) bool {
+	if len(s.years) != len(s2.years) {
+		return false
+	}
+	for year, words := range s.years {
+		if words2 := s2.years[year]; words2 == nil || *words != *words2 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone is a copy which can be changed without changing s
+func (s DateSet) Clone() DateSet {
+	return s.combine(DateSet{}, func(a, b uint64) uint64 { return a })
+}
+
+// Union is every date in either set
+func (s DateSet) Union(s2 DateSet) DateSet {
+	return s.combine(s2, func(a, b uint64) uint64 { return a | b })
+}
+
+// Intersect is every date in both sets
+func (s DateSet) Intersect(s2 DateSet) DateSet {
+	return s.combine(s2, func(a, b uint64) uint64 { return a & b })
+}
+
+// Difference is every date in s which is not in s2
+func (s DateSet) Difference(s2 DateSet) DateSet {
+	return s.combine(s2, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// combine makes a new set by applying op to each word of every year in either set
+func (s DateSet) combine(s2 DateSet, op func(a, b uint64) uint64) DateSet {
+	var (
+		result = DateSet{years: make(map[int]*[dateSetWords]uint64)}
+		empty  [dateSetWords]uint64
+	)
+	for _, year := range mergeYears(s.years, s2.years) {
+		var a, b = s.years[year], s2.years[year]
+		if a == nil {
+			a = &empty
+		}
+		if b == nil {
+			b = &empty
+		}
+		var words [dateSetWords]uint64
+		for i := range words {
+			words[i] = op(a[i], b[i])
+		}
+		if words != empty {
+			result.years[year] = &words
+		}
+	}
+	return result
+}
+
+// Each calls fn for each date in order, stopping early when fn returns false
+func (s DateSet) Each(fn func(Date) bool) {
+	for _, year := range mergeYears(s.years, nil) {
+		var (
+			words = s.years[year]
+			jan1  = NewDate(year, 1, 1)
+		)
+		for i, w := range words {
+			for w != 0 {
+				day := i*64 + bits.TrailingZeros64(w)
+				if !fn(Date{jan1.days + int64(day)}) {
+					return
+				}
+				w &= w - 1
+			}
+		}
+	}
+}
+
+// Dates lists every date in order
+func (s DateSet) Dates() []Date {
+	var dates = make([]Date, 0, s.Len())
+	s.Each(func(d Date) bool {
+		dates = append(dates, d)
+		return true
+	})
+	return dates
+}
+
+// Ranges joins runs of dates into a DateRangeSet
+func (s DateSet) Ranges() DateRangeSet {
+	var ranges = make([]DateRange, 0)
+	s.Each(func(d Date) bool {
+		if n := len(ranges); n > 0 && ranges[n-1].Until.Next() == d {
+			ranges[n-1].Until = &d
+			return true
+		}
+		ranges = append(ranges, NewDateRangeUntil(d, &d))
+		return true
+	})
+	return DateRangeSet{ranges: ranges}
+}
+
+func (s DateSet) String() string {
+	return "[" + strings.Join(s.runs(), " ") + "]"
+}
+
+// MarshalJSON is a list of dates where runs of dates are a
+// "2026-01-01/2026-01-05" range, ["2026-01-01/2026-01-05","2026-03-01"]
+func (s DateSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.runs())
+}
+
+// UnmarshalJSON takes a list of dates and ranges as made by MarshalJSON
+func (s *DateSet) UnmarshalJSON(b []byte) error {
+	var items []string
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	var set DateSet
+	for _, item := range items {
+		if !strings.Contains(item, "/") {
+			d, err := ParseDateLayout(ymdFormat, item)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrInvalidDateString, item)
+			}
+			set.Add(d)
+			continue
+		}
+		dr, err := ParseDateRange(item)
+		if err != nil {
+			return err
+		}
+		if err := set.AddRange(dr); err != nil {
+			return err
+		}
+	}
+	*s = set
+	return nil
+}
+
+// runs is each date, or range for runs of more than one date, as text
+func (s DateSet) runs() []string {
+	var (
+		ranges = s.Ranges().ranges
+		runs   = make([]string, len(ranges))
+	)
+	for i, dr := range ranges {
+		if dr.From == *dr.Until {
+			runs[i] = dr.From.String()
+			continue
+		}
+		text, _ := dr.MarshalText()
+		runs[i] = string(text)
+	}
+	return runs
+}
+
+// mergeYears is the sorted years found in either map
+func mergeYears(a, b map[int]*[dateSetWords]uint64) []int {
+	var years = make([]int, 0, len(a)+len(b))
+	for year := range a {
+		years = append(years, year)
+	}
+	for year := range b {
+		if _, ok := a[year]; !ok {
+			years = append(years, year)
+		}
+	}
+	sort.Ints(years)
+	return years
+}
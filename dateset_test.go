@@ -0,0 +1,109 @@
+package schedule_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestDateSet(t *testing.T) {
+	var (
+		day = func(m, d int) schedule.Date { return schedule.NewDate(2026, time.Month(m), d) }
+		dr  = func(m1, d1, m2, d2 int) schedule.DateRange {
+			return schedule.NewDateRangeUntil(day(m1, d1), day(m2, d2).Pointer())
+		}
+	)
+
+	t.Run("Add Remove Contains", func(t *testing.T) {
+		var s schedule.DateSet
+		assert.True(t, s.IsEmpty())
+		assert.False(t, s.Contains(day(1, 1)))
+
+		s.Add(day(1, 1), day(12, 31), schedule.NewDate(2028, 12, 31), schedule.Date{})
+		assert.Equal(t, 3, s.Len())
+		assert.True(t, s.Contains(day(1, 1)))
+		assert.True(t, s.Contains(day(12, 31)))
+		assert.True(t, s.Contains(schedule.NewDate(2028, 12, 31)))
+		assert.False(t, s.Contains(day(1, 2)))
+		assert.False(t, s.Contains(schedule.Date{}))
+
+		s.Remove(day(1, 1), day(1, 2), schedule.NewDate(2028, 12, 31))
+		assert.Equal(t, 1, s.Len())
+		assert.False(t, s.Contains(day(1, 1)))
+		s.Remove(day(12, 31))
+		assert.True(t, s.IsEmpty())
+		assert.True(t, s.Equal(schedule.NewDateSet()))
+
+		// the zero Date is never in the set, even with a date of its year
+		var year0 = schedule.NewDate(0, time.June, 1)
+		s.Add(year0)
+		assert.False(t, s.Contains(schedule.Date{}))
+		assert.NotPanics(t, func() { s.Remove(schedule.Date{}) })
+		assert.True(t, s.Contains(year0))
+	})
+
+	t.Run("ranges", func(t *testing.T) {
+		s, err := schedule.NewDateSetFromRanges(dr(12, 24, 12, 26), dr(1, 1, 1, 1), dr(12, 26, 12, 31))
+		require.NoError(t, err)
+		assert.Equal(t, 9, s.Len())
+		assert.Equal(t, "from 2026-01-01 until 2026-01-01, from 2026-12-24 until 2026-12-31", s.Ranges().String())
+		assert.Equal(t, []schedule.Date{day(1, 1), day(12, 24)}, s.Dates()[:2])
+
+		// runs join across years
+		s.Add(schedule.NewDate(2027, 1, 1))
+		assert.Equal(t, "from 2026-12-24 until 2027-01-01", s.Ranges().Ranges()[1].String())
+
+		_, err = schedule.NewDateSetFromRanges(schedule.NewDateRangeUntil(day(1, 1), nil))
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+	})
+
+	t.Run("Union Intersect Difference", func(t *testing.T) {
+		var (
+			a = schedule.NewDateSet(day(1, 1), day(1, 2), schedule.NewDate(2027, 1, 1))
+			b = schedule.NewDateSet(day(1, 2), day(1, 3))
+		)
+		assert.Equal(t, "[2026-01-01/2026-01-03 2027-01-01]", a.Union(b).String())
+		assert.Equal(t, "[2026-01-02]", a.Intersect(b).String())
+		assert.Equal(t, "[2026-01-01 2027-01-01]", a.Difference(b).String())
+		assert.True(t, a.Difference(a).IsEmpty())
+		assert.True(t, a.Union(schedule.DateSet{}).Equal(a))
+
+		c := a.Clone()
+		c.Remove(day(1, 1))
+		assert.True(t, a.Contains(day(1, 1)))
+		assert.False(t, c.Equal(a))
+	})
+
+	t.Run("Each stops early", func(t *testing.T) {
+		var (
+			s     = schedule.NewDateSet(schedule.NewDate(2027, 1, 1), day(3, 1), day(1, 1))
+			dates []schedule.Date
+		)
+		s.Each(func(d schedule.Date) bool {
+			dates = append(dates, d)
+			return len(dates) < 2
+		})
+		assert.Equal(t, []schedule.Date{day(1, 1), day(3, 1)}, dates)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		s, err := schedule.NewDateSetFromRanges(dr(12, 24, 12, 26), dr(1, 1, 1, 1))
+		require.NoError(t, err)
+		b, err := json.Marshal(s)
+		require.NoError(t, err)
+		assert.Equal(t, `["2026-01-01","2026-12-24/2026-12-26"]`, string(b))
+
+		var s2 schedule.DateSet
+		require.NoError(t, json.Unmarshal(b, &s2))
+		assert.True(t, s.Equal(s2))
+
+		require.NoError(t, json.Unmarshal([]byte(`[]`), &s2))
+		assert.True(t, s2.IsEmpty())
+		assert.Error(t, json.Unmarshal([]byte(`["2026-13-01"]`), &s2))
+		assert.ErrorIs(t, json.Unmarshal([]byte(`["2026-01-01/.."]`), &s2), schedule.ErrInfiniteDateRange)
+	})
+}
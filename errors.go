@@ -2,11 +2,113 @@ package schedule
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 var (
-	ErrFromRequired      = errors.New("from is required")
-	ErrPastUntil         = errors.New("until can not be before from")
-	ErrInvalidDayName    = errors.New("invalid day name")
-	ErrInvalidDateString = errors.New("can not parse date, must use yyyy-mm-dd format")
+	ErrFromRequired               = errors.New("from is required")
+	ErrPastUntil                  = errors.New("until can not be before from")
+	ErrInfiniteDateRange          = errors.New("date range has no until, it is infinite")
+	ErrInvalidSplitSize           = errors.New("split size must be at least 1 day")
+	ErrInvalidDayName             = errors.New("invalid day name")
+	ErrInvalidDateString          = errors.New("can not parse date, must use yyyy-mm-dd format")
+	ErrUnparsableDate             = errors.New("can not parse date")
+	ErrAmbiguousDate              = errors.New("date is ambiguous, more than one layout matched")
+	ErrInvalidYearMonthString     = errors.New("can not parse year month, must use yyyy-mm format")
+	ErrInvalidYearWeekString      = errors.New("can not parse year week, must use yyyy-Www format")
+	ErrInvalidMonthDayString      = errors.New("can not parse month day, must use mm-dd format")
+	ErrInvalidPeriodString        = errors.New("can not parse period, must use ISO 8601 PnYnMnD format")
+	ErrInvalidDateRangeString     = errors.New("can not parse date range, must use yyyy-mm-dd/yyyy-mm-dd format")
+	ErrInvalidLocalDateTimeString = errors.New("can not parse local date time, must use yyyy-mm-dd hh:mm format")
+	ErrTimeSlotConflict           = errors.New("timeslots overlap")
+	ErrDuplicateTimeSlot          = errors.New("duplicate timeslot")
+	ErrZeroLengthSlot             = errors.New("timeslot has no length")
+	ErrInvalidWeekday             = errors.New("invalid weekday")
+	ErrInvalidSlotKey             = errors.New("invalid timeslot key")
+	ErrInvalidFiscalCalendar      = errors.New("invalid fiscal calendar")
+	ErrInvalidHolidayRule         = errors.New("invalid holiday rule")
+	ErrNoBusinessDay              = errors.New("no business day within a year")
 )
+
+// TimeSlotError is a problem with a single timeslot
+// Index is the position of the slot within the list being validated
+type TimeSlotError struct {
+	Index int
+	Slot  WeekdayTimeSlot
+	Err   error
+}
+
+func (e TimeSlotError) Error() string {
+	return fmt.Sprintf("timeslots[%d] %s: %s", e.Index, e.Slot, e.Err)
+}
+
+func (e TimeSlotError) Unwrap() error { return e.Err }
+
+// DateRangeError is a problem with a DateRange
+type DateRangeError struct {
+	DateRange DateRange
+	Err       error
+}
+
+func (e DateRangeError) Error() string {
+	return fmt.Sprintf("dateRange %s: %s", e.DateRange, e.Err)
+}
+
+func (e DateRangeError) Unwrap() error { return e.Err }
+
+// SeasonError is a problem with the Season of a Schedule
+type SeasonError struct {
+	Season AnnualDateRange
+	Err    error
+}
+
+func (e SeasonError) Error() string {
+	return fmt.Sprintf("season %s: %s", e.Season, e.Err)
+}
+
+func (e SeasonError) Unwrap() error { return e.Err }
+
+// ValidationErrors collects every problem found while validating a value
+// rather than stopping at the first one
+type ValidationErrors []error
+
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap allows errors.Is and errors.As to inspect each error (go1.20+)
+func (errs ValidationErrors) Unwrap() []error { return errs }
+
+// Is reports whether any of the errors match target
+func (errs ValidationErrors) Is(target error) bool {
+	for _, err := range errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error that matches target
+func (errs ValidationErrors) As(target interface{}) bool {
+	for _, err := range errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns nil when there are no errors
+// so that an empty ValidationErrors is never returned as a non-nil error
+func (errs ValidationErrors) Err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
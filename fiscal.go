@@ -0,0 +1,212 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalCalendar maps dates to a fiscal year, quarter, period and week
+// a fiscal year has 4 quarters of 3 periods each
+//
+// by default periods are calendar months, so a fiscal year starting in July
+// has Q1 from July through September
+//
+// when Pattern is set, such as 4,4,5, the calendar is week based instead:
+// each quarter has periods of 4, 4 and 5 weeks and the year starts on the
+// WeekStart nearest the first of StartMonth, so years have 52 or 53 weeks
+// and the extra week is added to the last period
+type FiscalCalendar struct {
+	// StartMonth is the first month of the fiscal year
+	StartMonth time.Month
+
+	// LabelByStartYear names a fiscal year after the calendar year it starts in
+	// by default it is named after the year it ends in
+	// so July 2026 through June 2027 is fiscal year 2027
+	LabelByStartYear bool
+
+	// Pattern is the number of weeks in each period of a quarter, it must have
+	// 3 values which add up to 13, empty means periods are calendar months
+	Pattern []int
+
+	// WeekStart is the first day of each fiscal week
+	WeekStart Weekday
+}
+
+// FiscalDate is where a date falls within a FiscalCalendar
+type FiscalDate struct {
+	Year    int `json:"year"`
+	Quarter int `json:"quarter"`
+	Period  int `json:"period"`
+	Week    int `json:"week"`
+}
+
+func (fd FiscalDate) String() string {
+	return fmt.Sprintf("FY%04d Q%d P%02d W%02d", fd.Year, fd.Quarter, fd.Period, fd.Week)
+}
+
+// NewFiscalCalendar has calendar month periods and weeks starting on Sunday
+func NewFiscalCalendar(startMonth time.Month) FiscalCalendar {
+	return FiscalCalendar{StartMonth: startMonth, WeekStart: Sunday}
+}
+
+// New445FiscalCalendar is a week based calendar with 4-4-5 week periods
+// use WithPattern for 4-5-4 or 5-4-4
+func New445FiscalCalendar(startMonth time.Month, weekStart Weekday) FiscalCalendar {
+	return FiscalCalendar{
+		StartMonth: startMonth,
+		Pattern:    []int{4, 4, 5},
+		WeekStart:  weekStart,
+	}
+}
+
+func (fc FiscalCalendar) WithPattern(weeks ...int) FiscalCalendar {
+	fc.Pattern = weeks
+	return fc
+}
+
+func (fc FiscalCalendar) WithLabelByStartYear() FiscalCalendar {
+	fc.LabelByStartYear = true
+	return fc
+}
+
+// Validate checks StartMonth, WeekStart and Pattern
+// the other methods assume the FiscalCalendar is valid
+func (fc FiscalCalendar) Validate() error {
+	if fc.StartMonth < time.January || fc.StartMonth > time.December {
+		return fmt.Errorf("%w: start month %d", ErrInvalidFiscalCalendar, fc.StartMonth)
+	}
+	if !fc.WeekStart.IsValid() {
+		return fmt.Errorf("%w: week start %d", ErrInvalidFiscalCalendar, fc.WeekStart)
+	}
+	if len(fc.Pattern) == 0 {
+		return nil
+	}
+	var weeks int
+	for _, n := range fc.Pattern {
+		if n < 1 {
+			return fmt.Errorf("%w: pattern %v", ErrInvalidFiscalCalendar, fc.Pattern)
+		}
+		weeks += n
+	}
+	if len(fc.Pattern) != 3 || weeks != 13 {
+		return fmt.Errorf("%w: pattern %v must be 3 periods of 13 weeks", ErrInvalidFiscalCalendar, fc.Pattern)
+	}
+	return nil
+}
+
+func (fc FiscalCalendar) isWeekBased() bool { return len(fc.Pattern) > 0 }
+
+// FiscalDate finds the fiscal year, quarter, period and week of d
+func (fc FiscalCalendar) FiscalDate(d Date) FiscalDate {
+	var (
+		year   = fc.FiscalYear(d)
+		period = 12
+	)
+	for p := 1; p < 12; p++ {
+		if d.Before(fc.periodStart(year, p+1)) {
+			period = p
+			break
+		}
+	}
+	return FiscalDate{
+		Year:    year,
+		Quarter: (period-1)/3 + 1,
+		Period:  period,
+		Week:    d.Sub(fc.weekOneStart(year))/7 + 1,
+	}
+}
+
+// FiscalYear is the label of the fiscal year containing d
+func (fc FiscalCalendar) FiscalYear(d Date) int {
+	year := d.Year()
+	if !fc.LabelByStartYear && fc.StartMonth != time.January {
+		year++
+	}
+	if d.Before(fc.yearStart(year)) {
+		return year - 1
+	}
+	if !d.Before(fc.yearStart(year + 1)) {
+		return year + 1
+	}
+	return year
+}
+
+// YearRange is every date of the fiscal year
+func (fc FiscalCalendar) YearRange(year int) DateRange {
+	return fc.dateRange(fc.yearStart(year), fc.yearStart(year+1))
+}
+
+// QuarterRange is every date of the fiscal quarter, 1 through 4
+func (fc FiscalCalendar) QuarterRange(year, quarter int) DateRange {
+	return fc.dateRange(fc.periodStart(year, quarter*3-2), fc.periodStart(year, quarter*3+1))
+}
+
+// PeriodRange is every date of the fiscal period, 1 through 12
+func (fc FiscalCalendar) PeriodRange(year, period int) DateRange {
+	return fc.dateRange(fc.periodStart(year, period), fc.periodStart(year, period+1))
+}
+
+// WeekRange is every date of the fiscal week, starting at 1
+// with calendar month periods the first and last weeks may be partial
+// because they are cut off at the start and end of the fiscal year
+func (fc FiscalCalendar) WeekRange(year, week int) DateRange {
+	var (
+		start = fc.weekOneStart(year).AddDate(0, 0, (week-1)*7)
+		next  = start.AddDate(0, 0, 7)
+	)
+	return fc.dateRange(
+		*MaxDate(&start, fc.yearStart(year).Pointer()),
+		*MinDate(&next, fc.yearStart(year+1).Pointer()),
+	)
+}
+
+// WeeksInYear is the number of weeks in the fiscal year
+// which includes partial weeks when periods are calendar months
+func (fc FiscalCalendar) WeeksInYear(year int) int {
+	return fc.FiscalDate(fc.yearStart(year+1).AddDate(0, 0, -1)).Week
+}
+
+// dateRange is from start until the day before next
+func (fc FiscalCalendar) dateRange(start, next Date) DateRange {
+	return NewDateRangeUntil(start, next.AddDate(0, 0, -1).Pointer())
+}
+
+// yearStart is the first day of the fiscal year
+func (fc FiscalCalendar) yearStart(year int) Date {
+	if !fc.LabelByStartYear && fc.StartMonth != time.January {
+		year--
+	}
+	first := NewDate(year, fc.StartMonth, 1)
+	if !fc.isWeekBased() {
+		return first
+	}
+
+	// the WeekStart nearest to the first of the month
+	start := first.StartOfWeek(fc.WeekStart)
+	if first.Sub(start) > 3 {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start
+}
+
+// periodStart is the first day of the period, period 13 is the next year
+func (fc FiscalCalendar) periodStart(year, period int) Date {
+	if period > 12 {
+		return fc.yearStart(year + 1)
+	}
+	start := fc.yearStart(year)
+	if !fc.isWeekBased() {
+		return start.AddDate(0, period-1, 0)
+	}
+	var weeks int
+	for p := 1; p < period; p++ {
+		weeks += fc.Pattern[(p-1)%len(fc.Pattern)]
+	}
+	return start.AddDate(0, 0, weeks*7)
+}
+
+// weekOneStart is the first day of week 1, which is before the start
+// of the fiscal year when the year does not start on WeekStart
+func (fc FiscalCalendar) weekOneStart(year int) Date {
+	return fc.yearStart(year).StartOfWeek(fc.WeekStart)
+}
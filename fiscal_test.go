@@ -0,0 +1,119 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestFiscalCalendar_months(t *testing.T) {
+	fc := schedule.NewFiscalCalendar(time.July)
+	require.NoError(t, fc.Validate())
+
+	t.Run("FiscalDate", func(t *testing.T) {
+		var tests = map[string]schedule.FiscalDate{
+			"2026-07-01": {Year: 2027, Quarter: 1, Period: 1, Week: 1},
+			"2026-07-04": {Year: 2027, Quarter: 1, Period: 1, Week: 1},
+			"2026-07-05": {Year: 2027, Quarter: 1, Period: 1, Week: 2},
+			"2026-10-17": {Year: 2027, Quarter: 2, Period: 4, Week: 16},
+			"2027-01-01": {Year: 2027, Quarter: 3, Period: 7, Week: 27},
+			"2027-06-30": {Year: 2027, Quarter: 4, Period: 12, Week: 53},
+			"2026-06-30": {Year: 2026, Quarter: 4, Period: 12, Week: 53},
+		}
+		for dateStr, expect := range tests {
+			assert.Equal(t, expect, fc.FiscalDate(*schedule.ParseDate(dateStr)), dateStr)
+		}
+		assert.Equal(t, "FY2027 Q2 P04 W16", fc.FiscalDate(schedule.NewDate(2026, 10, 17)).String())
+	})
+
+	t.Run("ranges", func(t *testing.T) {
+		assert.Equal(t, "from 2026-07-01 until 2027-06-30", fc.YearRange(2027).String())
+		assert.Equal(t, "from 2026-10-01 until 2026-12-31", fc.QuarterRange(2027, 2).String())
+		assert.Equal(t, "from 2027-02-01 until 2027-02-28", fc.PeriodRange(2027, 8).String())
+		assert.Equal(t, "from 2026-07-01 until 2026-07-04", fc.WeekRange(2027, 1).String())
+		assert.Equal(t, "from 2026-07-05 until 2026-07-11", fc.WeekRange(2027, 2).String())
+		assert.Equal(t, "from 2027-06-27 until 2027-06-30", fc.WeekRange(2027, 53).String())
+		assert.Equal(t, 53, fc.WeeksInYear(2027))
+	})
+
+	t.Run("LabelByStartYear", func(t *testing.T) {
+		fc := fc.WithLabelByStartYear()
+		assert.Equal(t, 2026, fc.FiscalYear(schedule.NewDate(2026, 10, 17)))
+		assert.Equal(t, "from 2026-07-01 until 2027-06-30", fc.YearRange(2026).String())
+	})
+
+	t.Run("January start is the calendar year", func(t *testing.T) {
+		fc := schedule.NewFiscalCalendar(time.January)
+		assert.Equal(t, "from 2026-01-01 until 2026-12-31", fc.YearRange(2026).String())
+		assert.Equal(t, schedule.FiscalDate{Year: 2026, Quarter: 4, Period: 10, Week: 42},
+			fc.FiscalDate(schedule.NewDate(2026, 10, 17)))
+	})
+}
+
+func TestFiscalCalendar_445(t *testing.T) {
+	// retail calendar, 4-5-4 weeks, years start the Sunday nearest Feb 1
+	// and are named after the year they start in
+	fc := schedule.New445FiscalCalendar(time.February, schedule.Sunday).
+		WithPattern(4, 5, 4).
+		WithLabelByStartYear()
+	require.NoError(t, fc.Validate())
+
+	t.Run("52 and 53 week years", func(t *testing.T) {
+		assert.Equal(t, "from 2023-01-29 until 2024-02-03", fc.YearRange(2023).String())
+		assert.Equal(t, "from 2024-02-04 until 2025-02-01", fc.YearRange(2024).String())
+		assert.Equal(t, 53, fc.WeeksInYear(2023))
+		assert.Equal(t, 52, fc.WeeksInYear(2024))
+		assert.Equal(t, 53*7, fc.YearRange(2023).DayCount())
+	})
+
+	t.Run("periods follow the pattern", func(t *testing.T) {
+		assert.Equal(t, "from 2024-02-04 until 2024-03-02", fc.PeriodRange(2024, 1).String())
+		assert.Equal(t, "from 2024-03-03 until 2024-04-06", fc.PeriodRange(2024, 2).String())
+		assert.Equal(t, "from 2024-04-07 until 2024-05-04", fc.PeriodRange(2024, 3).String())
+		assert.Equal(t, "from 2024-02-04 until 2024-05-04", fc.QuarterRange(2024, 1).String())
+		assert.Equal(t, 13*7, fc.QuarterRange(2024, 4).DayCount())
+
+		// the 53rd week goes into the last period
+		assert.Equal(t, 5*7, fc.PeriodRange(2023, 12).DayCount())
+		assert.Equal(t, 14*7, fc.QuarterRange(2023, 4).DayCount())
+	})
+
+	t.Run("FiscalDate", func(t *testing.T) {
+		var tests = map[string]schedule.FiscalDate{
+			"2024-02-04": {Year: 2024, Quarter: 1, Period: 1, Week: 1},
+			"2024-03-02": {Year: 2024, Quarter: 1, Period: 1, Week: 4},
+			"2024-03-03": {Year: 2024, Quarter: 1, Period: 2, Week: 5},
+			"2024-02-03": {Year: 2023, Quarter: 4, Period: 12, Week: 53},
+			"2025-02-01": {Year: 2024, Quarter: 4, Period: 12, Week: 52},
+		}
+		for dateStr, expect := range tests {
+			assert.Equal(t, expect, fc.FiscalDate(*schedule.ParseDate(dateStr)), dateStr)
+		}
+		assert.Equal(t, "from 2024-03-03 until 2024-03-09", fc.WeekRange(2024, 5).String())
+	})
+
+	t.Run("every date is in exactly one period", func(t *testing.T) {
+		for d := schedule.NewDate(2020, 1, 1); d.Before(schedule.NewDate(2030, 1, 1)); d = d.Next() {
+			fd := fc.FiscalDate(d)
+			if !fc.PeriodRange(fd.Year, fd.Period).ContainsDate(d) || !fc.WeekRange(fd.Year, fd.Week).ContainsDate(d) {
+				t.Fatalf("%v is not in %v", d, fd)
+			}
+		}
+	})
+}
+
+func TestFiscalCalendar_Validate(t *testing.T) {
+	var invalid = map[string]schedule.FiscalCalendar{
+		"month":      schedule.NewFiscalCalendar(13),
+		"week start": schedule.New445FiscalCalendar(time.July, 7),
+		"13 weeks":   schedule.New445FiscalCalendar(time.July, schedule.Sunday).WithPattern(4, 4, 4),
+		"3 periods":  schedule.New445FiscalCalendar(time.July, schedule.Sunday).WithPattern(4, 9),
+		"positive":   schedule.New445FiscalCalendar(time.July, schedule.Sunday).WithPattern(9, 5, -1),
+	}
+	for name, fc := range invalid {
+		assert.ErrorIs(t, fc.Validate(), schedule.ErrInvalidFiscalCalendar, name)
+	}
+}
@@ -0,0 +1,343 @@
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// HolidayProvider says which dates are holidays
+// a HolidayCalendar is one, use HolidayFunc to adapt anything else
+// such as a DateSet, HolidayFunc(set.Contains)
+type HolidayProvider interface {
+	IsHoliday(d Date) bool
+}
+
+// HolidayFunc adapts a function into a HolidayProvider
+type HolidayFunc func(Date) bool
+
+func (f HolidayFunc) IsHoliday(d Date) bool { return f(d) }
+
+// isHoliday is false when p is nil
+func isHoliday(p HolidayProvider, d Date) bool {
+	return p != nil && p.IsHoliday(d)
+}
+
+// holidaysWithin is p ready to be asked about every date of dr, when p is a
+// HolidayCalendar its holidays are found once as a DateSet instead of once per date
+// other types are always asked, even ones embedding a HolidayCalendar, as
+// they may change IsHoliday
+func holidaysWithin(p HolidayProvider, dr DateRange) HolidayProvider {
+	var hc HolidayCalendar
+	switch v := p.(type) {
+	case HolidayCalendar:
+		hc = v
+	case *HolidayCalendar:
+		if v == nil {
+			return p
+		}
+		hc = *v
+	default:
+		return p
+	}
+	set, err := hc.Between(dr)
+	if err != nil {
+		return p
+	}
+	return HolidayFunc(set.Contains)
+}
+
+// HolidayKind is how a HolidayRule finds its date each year
+type HolidayKind string
+
+const (
+	// HolidayFixed is the same Month and Day every year, Dec 25
+	HolidayFixed HolidayKind = "fixed"
+	// HolidayNthWeekday is the N'th Weekday of Month, 4th Thursday of November
+	HolidayNthWeekday HolidayKind = "nth-weekday"
+	// HolidayLastWeekday is the last Weekday of Month, last Monday of May
+	HolidayLastWeekday HolidayKind = "last-weekday"
+	// HolidayEaster is Offset days from Easter Sunday, Good Friday is -2
+	HolidayEaster HolidayKind = "easter"
+)
+
+// HolidayObserved moves a holiday which falls on a weekend to a weekday
+type HolidayObserved string
+
+const (
+	// ObservedNone keeps the holiday on its date
+	ObservedNone HolidayObserved = ""
+	// ObservedNearestWeekday moves Saturday to Friday and Sunday to Monday
+	ObservedNearestWeekday HolidayObserved = "nearest-weekday"
+	// ObservedNextMonday moves both Saturday and Sunday to Monday
+	ObservedNextMonday HolidayObserved = "next-monday"
+)
+
+// HolidayRule finds the date of a holiday in any year
+// which fields are used depends on Kind
+//
+//	{"name":"Christmas","kind":"fixed","month":12,"day":25,"observed":"nearest-weekday"}
+//	{"name":"Thanksgiving","kind":"nth-weekday","month":11,"weekday":"Thursday","n":4}
+//	{"name":"Memorial Day","kind":"last-weekday","month":5,"weekday":"Monday"}
+//	{"name":"Good Friday","kind":"easter","offset":-2}
+type HolidayRule struct {
+	Name     string          `json:"name"`
+	Kind     HolidayKind     `json:"kind"`
+	Month    time.Month      `json:"month,omitempty"`
+	Day      int             `json:"day,omitempty"`
+	Weekday  Weekday         `json:"weekday,omitempty"`
+	N        int             `json:"n,omitempty"`
+	Offset   int             `json:"offset,omitempty"`
+	Observed HolidayObserved `json:"observed,omitempty"`
+}
+
+func FixedHoliday(name string, month time.Month, day int) HolidayRule {
+	return HolidayRule{Name: name, Kind: HolidayFixed, Month: month, Day: day}
+}
+
+// NthWeekdayHoliday is the n'th weekday of month, n is 1 to 5
+// in years where month has no 5th weekday there is no holiday
+func NthWeekdayHoliday(name string, n int, weekday Weekday, month time.Month) HolidayRule {
+	return HolidayRule{Name: name, Kind: HolidayNthWeekday, Month: month, Weekday: weekday, N: n}
+}
+
+func LastWeekdayHoliday(name string, weekday Weekday, month time.Month) HolidayRule {
+	return HolidayRule{Name: name, Kind: HolidayLastWeekday, Month: month, Weekday: weekday}
+}
+
+// EasterHoliday is offset days from Easter Sunday, Easter Monday is 1
+// offset is -80 to 250 so the holiday is always in the same year as Easter
+func EasterHoliday(name string, offset int) HolidayRule {
+	return HolidayRule{Name: name, Kind: HolidayEaster, Offset: offset}
+}
+
+func (r HolidayRule) WithObserved(o HolidayObserved) HolidayRule {
+	r.Observed = o
+	return r
+}
+
+func (r HolidayRule) String() string { return r.Name }
+
+// Validate checks the fields used by Kind
+// the other methods assume the HolidayRule is valid
+func (r HolidayRule) Validate() error {
+	switch r.Observed {
+	case ObservedNone, ObservedNearestWeekday, ObservedNextMonday:
+	default:
+		return fmt.Errorf("%w: %s observed %q", ErrInvalidHolidayRule, r.Name, r.Observed)
+	}
+
+	switch r.Kind {
+	case HolidayFixed:
+		return r.validateMonthDay()
+	case HolidayNthWeekday:
+		if r.N < 1 || r.N > 5 {
+			return fmt.Errorf("%w: %s n %d must be 1 to 5", ErrInvalidHolidayRule, r.Name, r.N)
+		}
+		return r.validateMonthWeekday()
+	case HolidayLastWeekday:
+		return r.validateMonthWeekday()
+	case HolidayEaster:
+		// Easter is Mar 22 to Apr 25 so these keep the holiday within its year
+		if r.Offset < -80 || r.Offset > 250 {
+			return fmt.Errorf("%w: %s offset %d must be -80 to 250", ErrInvalidHolidayRule, r.Name, r.Offset)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: %s kind %q", ErrInvalidHolidayRule, r.Name, r.Kind)
+}
+
+func (r HolidayRule) validateMonthDay() error {
+	if err := NewMonthDay(r.Month, r.Day).Validate(); err != nil {
+		return fmt.Errorf("%w: %s %v", ErrInvalidHolidayRule, r.Name, err)
+	}
+	return nil
+}
+
+func (r HolidayRule) validateMonthWeekday() error {
+	if r.Month < time.January || r.Month > time.December {
+		return fmt.Errorf("%w: %s month %d", ErrInvalidHolidayRule, r.Name, r.Month)
+	}
+	if !r.Weekday.IsValid() {
+		return fmt.Errorf("%w: %s weekday %d", ErrInvalidHolidayRule, r.Name, r.Weekday)
+	}
+	return nil
+}
+
+// Date is the holiday in year before it is moved by Observed
+// false is returned when there is none, such as a 5th Monday which does
+// not exist or Feb 29 in a year which is not a leap year
+func (r HolidayRule) Date(year int) (Date, bool) {
+	switch r.Kind {
+	case HolidayFixed:
+		if r.Day > daysIn(r.Month, year) {
+			return Date{}, false
+		}
+		return NewDate(year, r.Month, r.Day), true
+	case HolidayNthWeekday:
+		first := NewDate(year, r.Month, 1)
+		day := 1 + int(r.Weekday-first.Weekday()+7)%7 + (r.N-1)*7
+		if day > daysIn(r.Month, year) {
+			return Date{}, false
+		}
+		return NewDate(year, r.Month, day), true
+	case HolidayLastWeekday:
+		last := NewDate(year, r.Month, daysIn(r.Month, year))
+		return Date{last.days - int64(last.Weekday()-r.Weekday+7)%7}, true
+	case HolidayEaster:
+		return Date{EasterDate(year).days + int64(r.Offset)}, true
+	}
+	return Date{}, false
+}
+
+// ObservedDate is the date the holiday is taken in year after Observed moves it
+// which may be in another year, Saturday Jan 1 nearest weekday is Friday Dec 31
+func (r HolidayRule) ObservedDate(year int) (Date, bool) {
+	d, ok := r.Date(year)
+	if !ok {
+		return Date{}, false
+	}
+	switch w := d.Weekday(); {
+	case r.Observed == ObservedNearestWeekday && w == Saturday:
+		return Date{d.days - 1}, true
+	case r.Observed != ObservedNone && w == Saturday:
+		return Date{d.days + 2}, true
+	case r.Observed != ObservedNone && w == Sunday:
+		return Date{d.days + 1}, true
+	}
+	return d, true
+}
+
+// EasterDate is Easter Sunday in the Gregorian calendar
+// using the anonymous Gregorian computus (Meeus/Jones/Butcher)
+func EasterDate(year int) Date {
+	var (
+		a = year % 19
+		b = year / 100
+		c = year % 100
+		d = b / 4
+		e = b % 4
+		f = (b + 8) / 25
+		g = (b - f + 1) / 3
+		h = (19*a + b - d - g + 15) % 30
+		i = c / 4
+		k = c % 4
+		l = (32 + 2*e + 2*i - h - k) % 7
+		m = (a + 11*h + 22*l) / 451
+		n = h + l - 7*m + 114
+	)
+	return NewDate(year, time.Month(n/31), n%31+1)
+}
+
+// HolidayCalendar is a named set of HolidayRule's, such as the public
+// holidays of a country, it is a HolidayProvider of the observed dates
+type HolidayCalendar struct {
+	Name  string        `json:"name,omitempty"`
+	Rules []HolidayRule `json:"rules"`
+}
+
+var _ HolidayProvider = HolidayCalendar{}
+
+func NewHolidayCalendar(name string, rules ...HolidayRule) HolidayCalendar {
+	return HolidayCalendar{Name: name, Rules: rules}
+}
+
+// LoadHolidayCalendar decodes a json HolidayCalendar and validates it
+func LoadHolidayCalendar(r io.Reader) (HolidayCalendar, error) {
+	var hc HolidayCalendar
+	if err := json.NewDecoder(r).Decode(&hc); err != nil {
+		return HolidayCalendar{}, err
+	}
+	if err := hc.Validate(); err != nil {
+		return HolidayCalendar{}, err
+	}
+	return hc, nil
+}
+
+func (hc HolidayCalendar) WithRules(rules ...HolidayRule) HolidayCalendar {
+	hc.Rules = append(append([]HolidayRule{}, hc.Rules...), rules...)
+	return hc
+}
+
+// Validate returns ValidationErrors with one error for each invalid rule
+func (hc HolidayCalendar) Validate() error {
+	var errs ValidationErrors
+	for i, r := range hc.Rules {
+		if err := r.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("rules[%d] %w", i, err))
+		}
+	}
+	return errs.Err()
+}
+
+// Holidays is the observed date of every rule which falls within year
+// so a holiday moved into the year before or after is not included
+func (hc HolidayCalendar) Holidays(year int) DateSet {
+	var s DateSet
+	hc.each(year-1, year+1, func(_ HolidayRule, d Date) {
+		if d.Year() == year {
+			s.Add(d)
+		}
+	})
+	return s
+}
+
+// Between is the observed holidays within dr
+// ErrInfiniteDateRange is returned when dr is open start or goes on forever
+func (hc HolidayCalendar) Between(dr DateRange) (DateSet, error) {
+	if !dr.HasDays() {
+		return DateSet{}, nil
+	}
+	if dr.DayCount() == InfDays {
+		return DateSet{}, ErrInfiniteDateRange
+	}
+	var s DateSet
+	hc.each(dr.From.Year()-1, dr.Until.Year()+1, func(_ HolidayRule, d Date) {
+		if dr.ContainsDate(d) {
+			s.Add(d)
+		}
+	})
+	return s, nil
+}
+
+// IsHoliday is true when d is the observed date of any rule
+// every rule is worked out for each call, to check many dates use Between
+// which Calendar, Schedule and the business day methods do for you
+func (hc HolidayCalendar) IsHoliday(d Date) bool {
+	return len(hc.Names(d)) > 0
+}
+
+// Names of the rules observed on d
+func (hc HolidayCalendar) Names(d Date) []string {
+	// observed dates move at most two days, so only the first two days
+	// and the last day of the year can be a holiday of the year next to it
+	var (
+		md          = d.MonthDay()
+		first, last = d.Year(), d.Year()
+		names       []string
+	)
+	if md.month == time.January && md.day <= 2 {
+		first--
+	}
+	if md.month == time.December && md.day == 31 {
+		last++
+	}
+	hc.each(first, last, func(r HolidayRule, observed Date) {
+		if observed == d {
+			names = append(names, r.Name)
+		}
+	})
+	return names
+}
+
+// each calls fn with the observed date of every rule in each year from first to last
+func (hc HolidayCalendar) each(first, last int, fn func(HolidayRule, Date)) {
+	for year := first; year <= last; year++ {
+		for _, r := range hc.Rules {
+			if d, ok := r.ObservedDate(year); ok {
+				fn(r, d)
+			}
+		}
+	}
+}
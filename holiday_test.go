@@ -0,0 +1,201 @@
+package schedule_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestEasterDate(t *testing.T) {
+	for year, expect := range map[int]string{
+		1818: "1818-03-22", // earliest possible
+		2000: "2000-04-23",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2026: "2026-04-05",
+		2038: "2038-04-25", // latest possible
+	} {
+		assert.Equal(t, expect, schedule.EasterDate(year).String(), year)
+	}
+}
+
+func TestHolidayRule(t *testing.T) {
+	var tests = map[string]struct {
+		rule   schedule.HolidayRule
+		year   int
+		date   string
+		actual string
+	}{
+		"fixed":             {schedule.FixedHoliday("Christmas", time.December, 25), 2026, "2026-12-25", "2026-12-25"},
+		"fixed saturday":    {schedule.FixedHoliday("Christmas", time.December, 25).WithObserved(schedule.ObservedNearestWeekday), 2021, "2021-12-24", "2021-12-25"},
+		"fixed sunday":      {schedule.FixedHoliday("Christmas", time.December, 25).WithObserved(schedule.ObservedNearestWeekday), 2022, "2022-12-26", "2022-12-25"},
+		"next monday":       {schedule.FixedHoliday("Christmas", time.December, 25).WithObserved(schedule.ObservedNextMonday), 2021, "2021-12-27", "2021-12-25"},
+		"new year observed": {schedule.FixedHoliday("New Year", time.January, 1).WithObserved(schedule.ObservedNearestWeekday), 2022, "2021-12-31", "2022-01-01"},
+		"nth weekday":       {schedule.NthWeekdayHoliday("Thanksgiving", 4, schedule.Thursday, time.November), 2026, "2026-11-26", "2026-11-26"},
+		"first weekday":     {schedule.NthWeekdayHoliday("Labor Day", 1, schedule.Monday, time.September), 2026, "2026-09-07", "2026-09-07"},
+		"last weekday":      {schedule.LastWeekdayHoliday("Memorial Day", schedule.Monday, time.May), 2026, "2026-05-25", "2026-05-25"},
+		"last on last day":  {schedule.LastWeekdayHoliday("Last Sunday", schedule.Sunday, time.May), 2026, "2026-05-31", "2026-05-31"},
+		"easter":            {schedule.EasterHoliday("Good Friday", -2), 2026, "2026-04-03", "2026-04-03"},
+		"easter monday":     {schedule.EasterHoliday("Easter Monday", 1), 2026, "2026-04-06", "2026-04-06"},
+	}
+	for name, tc := range tests {
+		require.NoError(t, tc.rule.Validate(), name)
+		d, ok := tc.rule.ObservedDate(tc.year)
+		require.True(t, ok, name)
+		assert.Equal(t, tc.date, d.String(), name)
+		d, _ = tc.rule.Date(tc.year)
+		assert.Equal(t, tc.actual, d.String(), name)
+	}
+
+	_, ok := schedule.NthWeekdayHoliday("5th Monday", 5, schedule.Monday, time.February).Date(2026)
+	assert.False(t, ok)
+	_, ok = schedule.FixedHoliday("Leap Day", time.February, 29).Date(2026)
+	assert.False(t, ok)
+
+	for name, rule := range map[string]schedule.HolidayRule{
+		"no kind":     {Name: "x"},
+		"bad day":     schedule.FixedHoliday("x", time.April, 31),
+		"bad n":       schedule.NthWeekdayHoliday("x", 6, schedule.Monday, time.May),
+		"bad month":   schedule.LastWeekdayHoliday("x", schedule.Monday, 13),
+		"bad weekday": schedule.LastWeekdayHoliday("x", 7, time.May),
+		"bad observe": schedule.EasterHoliday("x", 0).WithObserved("sometimes"),
+		"bad offset":  schedule.EasterHoliday("x", -81),
+	} {
+		assert.ErrorIs(t, rule.Validate(), schedule.ErrInvalidHolidayRule, name)
+	}
+}
+
+func TestHolidayCalendar(t *testing.T) {
+	const usJSON = `{
+		"name": "US",
+		"rules": [
+			{"name": "New Year", "kind": "fixed", "month": 1, "day": 1, "observed": "nearest-weekday"},
+			{"name": "Memorial Day", "kind": "last-weekday", "month": 5, "weekday": "Monday"},
+			{"name": "Independence Day", "kind": "fixed", "month": 7, "day": 4, "observed": "nearest-weekday"},
+			{"name": "Thanksgiving", "kind": "nth-weekday", "month": 11, "weekday": "Thursday", "n": 4},
+			{"name": "Christmas", "kind": "fixed", "month": 12, "day": 25, "observed": "nearest-weekday"},
+			{"name": "Christmas Day", "kind": "fixed", "month": 12, "day": 25}
+		]
+	}`
+	us, err := schedule.LoadHolidayCalendar(strings.NewReader(usJSON))
+	require.NoError(t, err)
+	assert.Equal(t, "US", us.Name)
+
+	// New Year 2022 is a Saturday so it is observed in 2021
+	assert.Equal(t,
+		"[2021-01-01 2021-05-31 2021-07-05 2021-11-25 2021-12-24/2021-12-25 2021-12-31]",
+		us.Holidays(2021).String())
+	assert.Equal(t,
+		"[2022-05-30 2022-07-04 2022-11-24 2022-12-25/2022-12-26]",
+		us.Holidays(2022).String())
+	assert.True(t, us.IsHoliday(schedule.NewDate(2021, 12, 31)))
+	assert.False(t, us.IsHoliday(schedule.NewDate(2022, 1, 1)))
+	assert.Equal(t, []string{"Independence Day"}, us.Names(schedule.NewDate(2026, 7, 3)))
+
+	// Saturday Dec 31 2022 moved to Monday is a holiday of 2023
+	nye := schedule.NewHolidayCalendar("", schedule.FixedHoliday("NYE", time.December, 31).WithObserved(schedule.ObservedNextMonday))
+	assert.True(t, nye.IsHoliday(schedule.NewDate(2023, 1, 2)))
+	assert.False(t, nye.IsHoliday(schedule.NewDate(2022, 12, 31)))
+
+	var (
+		from  = schedule.NewDate(2021, 12, 1)
+		until = schedule.NewDate(2022, 6, 30)
+	)
+	between, err := us.Between(schedule.NewDateRangeUntil(from, &until))
+	require.NoError(t, err)
+	assert.Equal(t, "[2021-12-24/2021-12-25 2021-12-31 2022-05-30]", between.String())
+	_, err = us.Between(schedule.NewDateRangeUntil(from, nil))
+	assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+
+	_, err = schedule.LoadHolidayCalendar(strings.NewReader(`{"rules":[{"name":"x","kind":"fixed","month":2,"day":30}]}`))
+	assert.ErrorIs(t, err, schedule.ErrInvalidHolidayRule)
+}
+
+func TestCalendar_Holidays(t *testing.T) {
+	var (
+		mon         = schedule.WeekdayTimeSlotFromString("Monday 09:00-17:00")
+		from        = schedule.NewDate(2026, 5, 1)
+		until       = schedule.NewDate(2026, 5, 31)
+		memorial    = schedule.NewHolidayCalendar("", schedule.LastWeekdayHoliday("Memorial Day", schedule.Monday, time.May))
+		may18       = schedule.NewDate(2026, 5, 18)
+		closed      = schedule.HolidayFunc(schedule.NewDateSet(may18).Contains)
+		s           = schedule.NewSchedule(schedule.NewDateRangeUntil(from, &until), mon)
+		memorialDay = schedule.NewDate(2026, 5, 25)
+	)
+
+	cm, err := schedule.NewCalendar(s).WithHolidays(memorial).ByDateRange(s.DateRange)
+	require.NoError(t, err)
+	assert.Len(t, cm, 30)
+	assert.False(t, cm.HasDate(memorialDay))
+	assert.True(t, cm.HasDate(may18))
+
+	cm, err = schedule.NewCalendar(s.WithHolidays(closed)).WithHolidays(memorial).ByDateRange(s.DateRange)
+	require.NoError(t, err)
+	assert.Len(t, cm, 29)
+	assert.False(t, cm.HasDate(may18))
+
+	// May 2026 has 4 Mondays, one is Memorial Day
+	total, err := s.WithHolidays(memorial).TotalDuration(s.DateRange)
+	require.NoError(t, err)
+	assert.Equal(t, 3*8*time.Hour, total)
+	assert.Equal(t, memorial, s.WithHolidays(memorial).Merge(s).Holidays)
+}
+
+// dayAfterThanksgiving embeds a HolidayCalendar and closes the Friday after too
+type dayAfterThanksgiving struct {
+	schedule.HolidayCalendar
+}
+
+func (h dayAfterThanksgiving) IsHoliday(d schedule.Date) bool {
+	return h.HolidayCalendar.IsHoliday(d) || h.HolidayCalendar.IsHoliday(d.AddDate(0, 0, -1))
+}
+
+func TestHolidayCalendar_embedded(t *testing.T) {
+	var (
+		holidays = dayAfterThanksgiving{schedule.NewHolidayCalendar("",
+			schedule.NthWeekdayHoliday("Thanksgiving", 4, schedule.Thursday, time.November))}
+		jan1  = schedule.NewDate(2026, 1, 1)
+		dec31 = schedule.NewDate(2026, 12, 31)
+		year  = schedule.NewDateRangeUntil(jan1, &dec31)
+		s     = schedule.NewSchedule(year, schedule.WeekdayTimeSlotFromString("Friday 09:00-17:00"))
+		nov27 = schedule.NewDate(2026, 11, 27)
+	)
+
+	// the IsHoliday of the embedding type is used, not the one of HolidayCalendar
+	cm, err := schedule.NewCalendar(s).WithHolidays(holidays).ByDateRange(year)
+	require.NoError(t, err)
+	assert.False(t, cm.HasDate(nov27))
+	cm, err = schedule.NewCalendar(s.WithHolidays(&holidays)).ByDateRange(year)
+	require.NoError(t, err)
+	assert.False(t, cm.HasDate(nov27))
+
+	// 2026 has 52 Fridays
+	total, err := s.WithHolidays(holidays).TotalDuration(year)
+	require.NoError(t, err)
+	assert.Equal(t, 51*8*time.Hour, total)
+
+	assert.Equal(t, 259, schedule.NewDate(2025, 12, 31).BusinessDaysBetween(dec31, schedule.Weekend, holidays))
+	next, err := schedule.NewDate(2026, 11, 25).AddBusinessDays(1, schedule.Weekend, holidays)
+	require.NoError(t, err)
+	assert.Equal(t, schedule.NewDate(2026, 11, 30), next)
+}
+
+func BenchmarkDate_AddBusinessDays(b *testing.B) {
+	var (
+		us = schedule.NewHolidayCalendar("US",
+			schedule.FixedHoliday("New Year", time.January, 1).WithObserved(schedule.ObservedNearestWeekday),
+			schedule.LastWeekdayHoliday("Memorial Day", schedule.Monday, time.May),
+			schedule.NthWeekdayHoliday("Thanksgiving", 4, schedule.Thursday, time.November),
+			schedule.FixedHoliday("Christmas", time.December, 25).WithObserved(schedule.ObservedNearestWeekday),
+		)
+		d = schedule.NewDate(2026, 10, 16)
+	)
+	for i := 0; i < b.N; i++ {
+		benchDate, _ = d.AddBusinessDays(2500, schedule.Weekend, us)
+	}
+}
@@ -0,0 +1,182 @@
+package schedule
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const ldtFormat = "2006-01-02 15:04"
+
+// parseLDTLayouts are tried in order by ParseLocalDateTime
+// seconds are accepted but dropped because a Clock has minutes only
+var parseLDTLayouts = []string{
+	ldtFormat,
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+}
+
+var _ json.Marshaler = (*LocalDateTime)(nil)
+var _ json.Unmarshaler = (*LocalDateTime)(nil)
+var _ encoding.TextMarshaler = (*LocalDateTime)(nil)
+var _ encoding.TextUnmarshaler = (*LocalDateTime)(nil)
+var _ sql.Scanner = (*LocalDateTime)(nil)
+var _ driver.Valuer = (*LocalDateTime)(nil)
+
+// LocalDateTime is a Date and Clock without a location, "2026-10-17 09:30"
+// it is what a wall calendar shows, use In to find the moment it happens
+// somewhere in particular
+type LocalDateTime struct {
+	date  Date
+	clock Clock
+}
+
+func NewLocalDateTime(date Date, clock Clock) LocalDateTime {
+	return LocalDateTime{date: date, clock: clock}
+}
+
+// At is the date with the clock c
+func (d Date) At(c Clock) LocalDateTime { return NewLocalDateTime(d, c) }
+
+// NewLocalDateTimeFromTime is the date and clock of t in its own location
+func NewLocalDateTimeFromTime(t time.Time) LocalDateTime {
+	return NewLocalDateTime(newDateFromTime(t), NewClock(t.Hour(), t.Minute()))
+}
+
+// NewLocalDateTimeFromTimeIn is the date and clock of t in loc
+func NewLocalDateTimeFromTimeIn(t time.Time, loc *time.Location) LocalDateTime {
+	return NewLocalDateTimeFromTime(t.In(loc))
+}
+
+// ParseLocalDateTime takes "2006-01-02 15:04" or "2006-01-02T15:04"
+// with or without seconds, which are dropped
+func ParseLocalDateTime(s string) (LocalDateTime, error) {
+	for _, layout := range parseLDTLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return NewLocalDateTimeFromTime(t), nil
+		}
+	}
+	return LocalDateTime{}, fmt.Errorf("%w: %s", ErrInvalidLocalDateTimeString, s)
+}
+
+func (ldt LocalDateTime) String() string {
+	return ldt.date.String() + " " + ldt.clock.String()
+}
+
+// Format uses a time.Format layout, any zone in the layout shows as UTC
+func (ldt LocalDateTime) Format(layout string) string {
+	return ldt.In(time.UTC).Format(layout)
+}
+
+func (ldt LocalDateTime) Date() Date                     { return ldt.date }
+func (ldt LocalDateTime) Clock() Clock                   { return ldt.clock }
+func (ldt LocalDateTime) Weekday() Weekday               { return ldt.date.Weekday() }
+func (ldt LocalDateTime) WeekClock() WeekClock           { return NewWeekClock(ldt.Weekday(), ldt.clock) }
+func (ldt LocalDateTime) Before(ldt2 LocalDateTime) bool { return ldt.minutes() < ldt2.minutes() }
+func (ldt LocalDateTime) After(ldt2 LocalDateTime) bool  { return ldt.minutes() > ldt2.minutes() }
+func (ldt LocalDateTime) Equal(ldt2 LocalDateTime) bool  { return ldt == ldt2 }
+func (ldt LocalDateTime) Pointer() *LocalDateTime        { return &ldt }
+func (ldt *LocalDateTime) IsZero() bool                  { return ldt == nil || *ldt == LocalDateTime{} }
+func (ldt LocalDateTime) minutes() int64                 { return ldt.date.days*minutesPerDay + int64(ldt.clock.min) }
+
+// Add a duration, anything less than a minute is dropped
+// unlike Clock.Add the date moves when the clock passes midnight
+//
+//	2026-10-17 23:30 Add(time.Hour)  = 2026-10-18 00:30
+//	2026-10-17 00:30 Add(-time.Hour) = 2026-10-16 23:30
+func (ldt LocalDateTime) Add(d time.Duration) LocalDateTime {
+	var (
+		m    = ldt.clock.min + int(d/time.Minute)
+		days = m / minutesPerDay
+	)
+	if m %= minutesPerDay; m < 0 {
+		m += minutesPerDay
+		days--
+	}
+	date := ldt.date
+	if days != 0 {
+		date = date.AddDate(0, 0, days)
+	}
+	return NewLocalDateTime(date, Clock{m})
+}
+
+// AddDate moves the date the same way Date.AddDate does, the clock is kept
+func (ldt LocalDateTime) AddDate(years, months, days int) LocalDateTime {
+	return NewLocalDateTime(ldt.date.AddDate(years, months, days), ldt.clock)
+}
+
+// Sub is the wall clock duration from ldt2 until ldt, time zone changes
+// such as daylight saving are not known so every day is 24 hours
+func (ldt LocalDateTime) Sub(ldt2 LocalDateTime) time.Duration {
+	return time.Duration(ldt.minutes()-ldt2.minutes()) * time.Minute
+}
+
+// In is the moment this date and clock happen in loc
+// a clock skipped by daylight saving is normalized the same way time.Date does
+func (ldt LocalDateTime) In(loc *time.Location) time.Time {
+	return ldt.clock.ToTime(ldt.date, loc)
+}
+
+func (ldt LocalDateTime) MarshalText() (text []byte, err error) {
+	return []byte(ldt.String()), nil
+}
+
+func (ldt *LocalDateTime) UnmarshalText(text []byte) error {
+	v, err := ParseLocalDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*ldt = v
+	return nil
+}
+
+func (ldt LocalDateTime) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(ldt.String())
+	buffer.WriteString(`"`)
+	return buffer.Bytes(), nil
+}
+
+func (ldt *LocalDateTime) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	return ldt.UnmarshalText([]byte(s))
+}
+
+// Scan implements sql.Scanner, a time.Time is the date and clock as written
+// which is what a TIMESTAMP WITHOUT TIME ZONE column gives
+func (ldt *LocalDateTime) Scan(src interface{}) error {
+	if src == nil {
+		return nil
+	}
+	switch t := src.(type) {
+	case time.Time:
+		*ldt = NewLocalDateTimeFromTime(t)
+	case string:
+		return ldt.UnmarshalText([]byte(t))
+	case []byte:
+		return ldt.UnmarshalText(t)
+	default:
+		return fmt.Errorf("LocalDateTime.Scan requires a time, string or byte array in yyyy-mm-dd hh:mm format got %T %v", src, src)
+	}
+	return nil
+}
+
+func (ldt LocalDateTime) Value() (driver.Value, error) {
+	if ldt.IsZero() {
+		return nil, nil
+	}
+	return ldt.String(), nil
+}
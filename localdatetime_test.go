@@ -0,0 +1,131 @@
+package schedule_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestLocalDateTime(t *testing.T) {
+	var (
+		oct17 = schedule.NewDate(2026, 10, 17)
+		ldt   = oct17.At(schedule.NewClock(9, 30))
+	)
+
+	t.Run("accessors", func(t *testing.T) {
+		assert.Equal(t, "2026-10-17 09:30", ldt.String())
+		assert.Equal(t, oct17, ldt.Date())
+		assert.Equal(t, schedule.NewClock(9, 30), ldt.Clock())
+		assert.Equal(t, schedule.Saturday, ldt.Weekday())
+		assert.Equal(t, "Saturday 09:30", ldt.WeekClock().String())
+		assert.Equal(t, "Oct 17 9:30AM", ldt.Format("Jan 2 3:04PM"))
+		assert.True(t, schedule.LocalDateTime{}.Pointer().IsZero())
+		assert.False(t, ldt.Pointer().IsZero())
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		var tests = map[string]struct {
+			in     string
+			expect string
+			err    error
+		}{
+			"space":     {"2026-10-17 09:30", "2026-10-17 09:30", nil},
+			"T":         {"2026-10-17T09:30", "2026-10-17 09:30", nil},
+			"seconds":   {"2026-10-17 23:59:59", "2026-10-17 23:59", nil},
+			"T seconds": {"2026-10-17T00:00:00", "2026-10-17 00:00", nil},
+			"date only": {"2026-10-17", "", schedule.ErrInvalidLocalDateTimeString},
+			"bad clock": {"2026-10-17 25:00", "", schedule.ErrInvalidLocalDateTimeString},
+			"zone":      {"2026-10-17T09:30:00Z", "", schedule.ErrInvalidLocalDateTimeString},
+		}
+		for name, tc := range tests {
+			v, err := schedule.ParseLocalDateTime(tc.in)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), name)
+				continue
+			}
+			require.NoError(t, err, name)
+			assert.Equal(t, tc.expect, v.String(), name)
+		}
+	})
+
+	t.Run("Add rolls the date", func(t *testing.T) {
+		var tests = map[string]struct {
+			start  string
+			add    time.Duration
+			expect string
+		}{
+			"same day":        {"2026-10-17 09:30", 2 * time.Hour, "2026-10-17 11:30"},
+			"past midnight":   {"2026-10-17 23:30", time.Hour, "2026-10-18 00:30"},
+			"to midnight":     {"2026-10-17 23:30", 30 * time.Minute, "2026-10-18 00:00"},
+			"back a day":      {"2026-10-17 00:30", -time.Hour, "2026-10-16 23:30"},
+			"end of year":     {"2026-12-31 22:00", 26 * time.Hour, "2027-01-02 00:00"},
+			"back many days":  {"2026-03-01 00:00", -49 * time.Hour, "2026-02-26 23:00"},
+			"seconds dropped": {"2026-10-17 09:30", 59 * time.Second, "2026-10-17 09:30"},
+		}
+		for name, tc := range tests {
+			start, err := schedule.ParseLocalDateTime(tc.start)
+			require.NoError(t, err, name)
+			end := start.Add(tc.add)
+			assert.Equal(t, tc.expect, end.String(), name)
+			assert.Equal(t, tc.add.Truncate(time.Minute), end.Sub(start), name)
+		}
+		assert.Equal(t, "2026-11-17 09:30", ldt.AddDate(0, 1, 0).String())
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		later := ldt.Add(time.Minute)
+		assert.True(t, ldt.Before(later))
+		assert.True(t, later.After(ldt))
+		assert.False(t, ldt.After(later))
+		assert.True(t, ldt.Equal(later.Add(-time.Minute)))
+		assert.True(t, oct17.At(schedule.NewClock(23, 59)).Before(oct17.Next().At(schedule.Clock{})))
+	})
+
+	t.Run("In", func(t *testing.T) {
+		ny, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skip("no tz database")
+		}
+		assert.Equal(t, "2026-10-17T09:30:00-04:00", ldt.In(ny).Format(time.RFC3339))
+		assert.Equal(t, ldt, schedule.NewLocalDateTimeFromTime(ldt.In(ny)))
+		assert.Equal(t, "2026-10-17 13:30", schedule.NewLocalDateTimeFromTimeIn(ldt.In(ny), time.UTC).String())
+	})
+
+	t.Run("encoding", func(t *testing.T) {
+		type wrapper struct {
+			At schedule.LocalDateTime `json:"at"`
+		}
+		b, err := json.Marshal(wrapper{ldt})
+		require.NoError(t, err)
+		assert.Equal(t, `{"at":"2026-10-17 09:30"}`, string(b))
+
+		var w wrapper
+		require.NoError(t, json.Unmarshal(b, &w))
+		assert.Equal(t, ldt, w.At)
+		assert.Error(t, json.Unmarshal([]byte(`{"at":"nope"}`), &w))
+
+		v, err := ldt.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "2026-10-17 09:30", v)
+		v, err = schedule.LocalDateTime{}.Value()
+		require.NoError(t, err)
+		assert.Nil(t, v)
+
+		for _, src := range []interface{}{
+			"2026-10-17 09:30",
+			[]byte("2026-10-17T09:30:00"),
+			time.Date(2026, 10, 17, 9, 30, 0, 0, time.FixedZone("x", 3600)),
+		} {
+			var scanned schedule.LocalDateTime
+			require.NoError(t, scanned.Scan(src))
+			assert.Equal(t, ldt, scanned)
+		}
+		var scanned schedule.LocalDateTime
+		assert.Error(t, scanned.Scan(1))
+	})
+}
@@ -0,0 +1,68 @@
+package schedule
+
+import (
+	"sync"
+	"time"
+)
+
+// NowProvider is the source of the current time
+// anything which asks "what is now" should accept one so tests can
+// freeze or advance time with FakeNow instead of reading the wall clock
+type NowProvider interface {
+	Now() time.Time
+}
+
+// NowFunc adapts a function such as time.Now into a NowProvider
+type NowFunc func() time.Time
+
+func (f NowFunc) Now() time.Time { return f() }
+
+// nowFrom is the time from p, or the wall clock when p is nil
+func nowFrom(p NowProvider) time.Time {
+	if p == nil {
+		return time.Now()
+	}
+	return p.Now()
+}
+
+// FakeNow is a NowProvider which only moves when told to
+// it is safe to use from multiple goroutines
+type FakeNow struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+// NewFakeNow is frozen at t until Set or Advance is called
+func NewFakeNow(t time.Time) *FakeNow {
+	return &FakeNow{t: t}
+}
+
+func (f *FakeNow) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.t
+}
+
+// Set moves the time to t, which may be earlier than the current time
+func (f *FakeNow) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.t = t
+}
+
+// Advance moves the time forward by d, or back when d is negative
+func (f *FakeNow) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.t = f.t.Add(d)
+}
+
+// TodayFrom is the current date of p in the location of the time it returns
+func TodayFrom(p NowProvider) Date {
+	return NewDateFromTime(nowFrom(p))
+}
+
+// TodayFromIn is the current date of p in loc
+func TodayFromIn(p NowProvider, loc *time.Location) Date {
+	return NewDateFromTimeIn(nowFrom(p), loc)
+}
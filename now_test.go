@@ -0,0 +1,64 @@
+package schedule_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tempcke/schedule"
+)
+
+func TestFakeNow(t *testing.T) {
+	var (
+		ny       = time.FixedZone("New York", -4*60*60)
+		midnight = time.Date(2022, 7, 9, 23, 59, 0, 0, ny) // Saturday
+		now      = schedule.NewFakeNow(midnight)
+	)
+
+	t.Run("frozen", func(t *testing.T) {
+		assert.Equal(t, midnight, now.Now())
+		assert.Equal(t, "2022-07-09", schedule.TodayFrom(now).String())
+		assert.Equal(t, schedule.Saturday, schedule.TodayWeekdayFrom(now))
+		assert.Equal(t, "2022-07-10", schedule.TodayFromIn(now, time.UTC).String())
+		assert.Equal(t, "from 2022-07-09 until forever", schedule.NewDateRangeFromNow(now).String())
+	})
+
+	t.Run("advance past midnight", func(t *testing.T) {
+		now.Advance(time.Minute)
+		assert.Equal(t, "2022-07-10", schedule.TodayFrom(now).String())
+		assert.Equal(t, schedule.Sunday, schedule.TodayWeekdayFrom(now))
+
+		now.Advance(-time.Minute)
+		assert.Equal(t, "2022-07-09", schedule.TodayFrom(now).String())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		now.Set(time.Date(2020, 2, 29, 12, 0, 0, 0, time.UTC))
+		assert.Equal(t, "2020-02-29", schedule.TodayFrom(now).String())
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 24; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				now.Advance(time.Hour)
+				_ = schedule.TodayFrom(now)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC), now.Now())
+	})
+}
+
+func TestNowProvider_nil(t *testing.T) {
+	var (
+		before = schedule.TodayIn(time.UTC)
+		nilNow = schedule.TodayFromIn(nil, time.UTC)
+		after  = schedule.TodayFromIn(schedule.NowFunc(time.Now), time.UTC)
+	)
+	assert.False(t, nilNow.Before(before))
+	assert.False(t, after.Before(nilNow))
+}
@@ -0,0 +1,200 @@
+package schedule
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var _ json.Marshaler = (*Period)(nil)
+var _ json.Unmarshaler = (*Period)(nil)
+var _ encoding.TextMarshaler = (*Period)(nil)
+var _ encoding.TextUnmarshaler = (*Period)(nil)
+var _ sql.Scanner = (*Period)(nil)
+var _ driver.Valuer = (*Period)(nil)
+
+// Period is an ISO 8601 date based duration such as "P1Y2M10D"
+// it is an amount of years, months and days rather than a fixed number of days
+// so "P1M" is 28 to 31 days depending on the date it is added to
+type Period struct {
+	years  int
+	months int
+	days   int
+}
+
+func NewPeriod(years, months, days int) Period {
+	return Period{years: years, months: months, days: days}
+}
+
+// ParsePeriod takes the ISO 8601 date duration format "PnYnMnWnD"
+// weeks are converted into days, any part may be negative as may the
+// whole period so "-P1M2D" is the same as "P-1M-2D"
+func ParsePeriod(s string) (Period, error) {
+	var (
+		p    Period
+		str  = strings.ToUpper(s)
+		sign = 1
+	)
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		if str[0] == '-' {
+			sign = -1
+		}
+		str = str[1:]
+	}
+	if !strings.HasPrefix(str, "P") || len(str) < 3 {
+		return Period{}, fmt.Errorf("%w: %s", ErrInvalidPeriodString, s)
+	}
+	str = str[1:]
+
+	const units = "YMWD"
+	last := -1
+	for len(str) > 0 {
+		i := strings.IndexAny(str, units)
+		if i < 1 {
+			return Period{}, fmt.Errorf("%w: %s", ErrInvalidPeriodString, s)
+		}
+		n, err := strconv.Atoi(str[:i])
+		if err != nil {
+			return Period{}, fmt.Errorf("%w: %s", ErrInvalidPeriodString, s)
+		}
+		unit := strings.IndexByte(units, str[i])
+		if unit <= last {
+			// units must be in order and only used once
+			return Period{}, fmt.Errorf("%w: %s", ErrInvalidPeriodString, s)
+		}
+		last = unit
+		switch str[i] {
+		case 'Y':
+			p.years = n * sign
+		case 'M':
+			p.months = n * sign
+		case 'W':
+			p.days += n * 7 * sign
+		case 'D':
+			p.days += n * sign
+		}
+		str = str[i+1:]
+	}
+	return p, nil
+}
+
+// String is the ISO 8601 format, leaving out zero parts, "P0D" when zero
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+	var b strings.Builder
+	b.WriteString("P")
+	if p.years != 0 {
+		b.WriteString(strconv.Itoa(p.years) + "Y")
+	}
+	if p.months != 0 {
+		b.WriteString(strconv.Itoa(p.months) + "M")
+	}
+	if p.days != 0 {
+		b.WriteString(strconv.Itoa(p.days) + "D")
+	}
+	return b.String()
+}
+
+func (p Period) Years() int           { return p.years }
+func (p Period) Months() int          { return p.months }
+func (p Period) Days() int            { return p.days }
+func (p Period) TotalMonths() int     { return p.years*12 + p.months }
+func (p Period) IsZero() bool         { return p == Period{} }
+func (p Period) Equal(p2 Period) bool { return p == p2 }
+func (p Period) Negated() Period      { return NewPeriod(-p.years, -p.months, -p.days) }
+func (p Period) Plus(p2 Period) Period {
+	return NewPeriod(p.years+p2.years, p.months+p2.months, p.days+p2.days)
+}
+func (p Period) Multiplied(n int) Period { return NewPeriod(p.years*n, p.months*n, p.days*n) }
+
+// Normalized moves whole years out of months, so "P1Y14M" is "P2Y2M"
+// days are left alone because months do not have a fixed number of days
+func (p Period) Normalized() Period {
+	total := p.TotalMonths()
+	return NewPeriod(total/12, total%12, p.days)
+}
+
+// AddPeriod adds the years and months together, keeping the day within
+// the resulting month (see AddMonthsClamped), and then adds the days
+//
+//	2026-01-31 AddPeriod(P1M)   = 2026-02-28
+//	2026-01-31 AddPeriod(P1M1D) = 2026-03-01
+func (d Date) AddPeriod(p Period) Date {
+	return d.AddMonthsClamped(p.TotalMonths()).AddDate(0, 0, p.days)
+}
+
+// PeriodUntil is the years, months and days from d until end
+// the result is negative when end is before d
+// and d.AddPeriod(d.PeriodUntil(end)) is always end
+//
+//	2026-01-31 PeriodUntil 2026-03-01 = P1M1D
+//	2026-03-01 PeriodUntil 2026-01-31 = P-1M-1D
+func (d Date) PeriodUntil(end Date) Period {
+	months := end.YearMonth().Sub(d.YearMonth())
+	if days := end.Day() - d.Day(); months > 0 && days < 0 {
+		months--
+	} else if months < 0 && days > 0 {
+		months++
+	}
+	// the days are counted from the clamped date so that adding works out
+	days := end.Sub(d.AddMonthsClamped(months))
+	return NewPeriod(months/12, months%12, days)
+}
+
+func (p Period) MarshalText() (text []byte, err error) {
+	return []byte(p.String()), nil
+}
+
+func (p *Period) UnmarshalText(text []byte) error {
+	v, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+func (p Period) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(p.String())
+	buffer.WriteString(`"`)
+	return buffer.Bytes(), nil
+}
+
+func (p *Period) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	return p.UnmarshalText([]byte(s))
+}
+
+func (p *Period) Scan(src interface{}) error {
+	if src == nil {
+		return nil
+	}
+	switch t := src.(type) {
+	case string:
+		return p.UnmarshalText([]byte(t))
+	case []byte:
+		return p.UnmarshalText(t)
+	default:
+		return fmt.Errorf("Period.Scan requires a string or byte array in PnYnMnD format got %T %v", src, src)
+	}
+}
+
+func (p Period) Value() (driver.Value, error) {
+	return p.String(), nil
+}
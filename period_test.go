@@ -0,0 +1,152 @@
+package schedule_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestPeriod(t *testing.T) {
+	t.Run("interface impl check", func(t *testing.T) {
+		var (
+			v = (*schedule.Period)(nil)
+
+			_ json.Marshaler           = v
+			_ json.Unmarshaler         = v
+			_ encoding.TextMarshaler   = v
+			_ encoding.TextUnmarshaler = v
+			_ sql.Scanner              = v
+			_ driver.Valuer            = v
+		)
+	})
+
+	t.Run("parse and format", func(t *testing.T) {
+		var tests = map[string]struct {
+			period schedule.Period
+			str    string
+		}{
+			"P1Y2M10D":  {schedule.NewPeriod(1, 2, 10), "P1Y2M10D"},
+			"P6M":       {schedule.NewPeriod(0, 6, 0), "P6M"},
+			"p6m":       {schedule.NewPeriod(0, 6, 0), "P6M"},
+			"P2W":       {schedule.NewPeriod(0, 0, 14), "P14D"},
+			"P1W3D":     {schedule.NewPeriod(0, 0, 10), "P10D"},
+			"P0D":       {schedule.Period{}, "P0D"},
+			"-P1M2D":    {schedule.NewPeriod(0, -1, -2), "P-1M-2D"},
+			"P-1M-2D":   {schedule.NewPeriod(0, -1, -2), "P-1M-2D"},
+			"+P1Y":      {schedule.NewPeriod(1, 0, 0), "P1Y"},
+			"P1Y-3M":    {schedule.NewPeriod(1, -3, 0), "P1Y-3M"},
+			"P0Y0M100D": {schedule.NewPeriod(0, 0, 100), "P100D"},
+		}
+		for input, tc := range tests {
+			p, err := schedule.ParsePeriod(input)
+			require.NoError(t, err, input)
+			assert.Equal(t, tc.period, p, input)
+			assert.Equal(t, tc.str, p.String(), input)
+		}
+
+		for _, input := range []string{"", "P", "1Y", "PY", "P1", "P1D1Y", "P1M1M", "P1H", "PT1H", "P1.5D", "P1Y2"} {
+			_, err := schedule.ParsePeriod(input)
+			assert.ErrorIs(t, err, schedule.ErrInvalidPeriodString, input)
+		}
+	})
+
+	t.Run("methods", func(t *testing.T) {
+		p := schedule.NewPeriod(1, 14, 3)
+		assert.Equal(t, 1, p.Years())
+		assert.Equal(t, 14, p.Months())
+		assert.Equal(t, 3, p.Days())
+		assert.Equal(t, 26, p.TotalMonths())
+		assert.Equal(t, schedule.NewPeriod(2, 2, 3), p.Normalized())
+		assert.Equal(t, schedule.NewPeriod(-1, -2, -3), schedule.NewPeriod(1, 2, 3).Negated())
+		assert.Equal(t, schedule.NewPeriod(0, -10, -3), schedule.NewPeriod(0, -10, -3).Normalized())
+		assert.Equal(t, schedule.NewPeriod(1, 15, 4), p.Plus(schedule.NewPeriod(0, 1, 1)))
+		assert.Equal(t, schedule.NewPeriod(2, 28, 6), p.Multiplied(2))
+		assert.True(t, p.Equal(schedule.NewPeriod(1, 14, 3)))
+		assert.True(t, schedule.Period{}.IsZero())
+	})
+
+	t.Run("AddPeriod", func(t *testing.T) {
+		jan31 := schedule.NewDate(2026, 1, 31)
+		var tests = map[string]string{
+			"P1M":     "2026-02-28",
+			"P1M1D":   "2026-03-01",
+			"P6M":     "2026-07-31",
+			"P1Y1M":   "2027-02-28",
+			"P2W":     "2026-02-14",
+			"-P1M":    "2025-12-31",
+			"P-2M-1D": "2025-11-29",
+		}
+		for input, expect := range tests {
+			p, err := schedule.ParsePeriod(input)
+			require.NoError(t, err)
+			assert.Equal(t, expect, jan31.AddPeriod(p).String(), input)
+		}
+	})
+
+	t.Run("PeriodUntil", func(t *testing.T) {
+		var tests = []struct {
+			from, until, expect string
+		}{
+			{"2026-01-31", "2026-03-01", "P1M1D"},
+			{"2026-03-01", "2026-01-31", "P-1M-1D"},
+			{"2026-01-15", "2027-03-20", "P1Y2M5D"},
+			{"2026-10-17", "2026-10-17", "P0D"},
+			{"2024-02-29", "2025-02-28", "P11M30D"},
+			{"2020-05-10", "2026-05-09", "P5Y11M29D"},
+			{"2026-05-09", "2020-05-10", "P-5Y-11M-30D"},
+			{"2026-03-31", "2026-02-15", "P-1M-13D"},
+		}
+		for _, tc := range tests {
+			var (
+				from  = *schedule.ParseDate(tc.from)
+				until = *schedule.ParseDate(tc.until)
+				p     = from.PeriodUntil(until)
+			)
+			assert.Equal(t, tc.expect, p.String(), tc.from+" "+tc.until)
+			assert.Equal(t, until, from.AddPeriod(p), tc.from+" "+tc.until)
+		}
+
+		// adding the result always gets back to the end date
+		start := schedule.NewDate(2023, 12, 1)
+		for i := 0; i < 120; i++ {
+			from := start.AddDate(0, 0, i*3)
+			for j := -400; j <= 400; j += 7 {
+				until := from.AddDate(0, 0, j)
+				require.Equal(t, until, from.AddPeriod(from.PeriodUntil(until)), "%v %v", from, until)
+			}
+		}
+	})
+
+	t.Run("json and sql", func(t *testing.T) {
+		type Config struct {
+			Renewal schedule.Period `json:"renewal"`
+		}
+		in := Config{Renewal: schedule.NewPeriod(0, 6, 0)}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.Equal(t, `{"renewal":"P6M"}`, string(b))
+
+		var out Config
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+		assert.Error(t, json.Unmarshal([]byte(`{"renewal":"6 months"}`), &out))
+
+		v, err := in.Renewal.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "P6M", v)
+
+		for _, src := range []interface{}{"P6M", []byte("P6M")} {
+			var p schedule.Period
+			require.NoError(t, p.Scan(src))
+			assert.Equal(t, in.Renewal, p)
+		}
+		var p schedule.Period
+		assert.Error(t, p.Scan(6))
+	})
+}
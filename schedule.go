@@ -1,8 +1,17 @@
 package schedule
 
+import (
+	"time"
+)
+
+// Schedule is the TimeSlots on each date of the DateRange
+// when Season is set only the dates within it are used, a zero DateRange
+// with a Season is every year, dates which are Holidays are closed
 type Schedule struct {
 	DateRange DateRange
 	TimeSlots []WeekdayTimeSlot
+	Season    *AnnualDateRange
+	Holidays  HolidayProvider
 }
 
 func NewSchedule(dr DateRange, slots ...WeekdayTimeSlot) Schedule {
@@ -27,6 +36,18 @@ func (s Schedule) WithUntil(until Date) Schedule {
 	return s
 }
 
+// WithSeason limits the schedule to the dates within a in every year
+func (s Schedule) WithSeason(a AnnualDateRange) Schedule {
+	s.Season = &a
+	return s
+}
+
+// WithHolidays closes the schedule on every holiday of p
+func (s Schedule) WithHolidays(p HolidayProvider) Schedule {
+	s.Holidays = p
+	return s
+}
+
 func (s Schedule) WithTimeSlots(slots ...WeekdayTimeSlot) Schedule {
 	s.TimeSlots = append(s.TimeSlots, slots...)
 	return s
@@ -38,13 +59,138 @@ func (s Schedule) Until() *Date { return s.DateRange.Until }
 // IsEmpty means there are either no days in range
 // or there are no timeslots, therefore nothing on schedule
 func (s Schedule) IsEmpty() bool {
-	return !s.DateRange.HasDays() || !s.HasTimeSlots()
+	return !(s.DateRange.HasDays() || s.isSeasonal()) || !s.HasTimeSlots()
+}
+
+// isSeasonal is true when the schedule is every year of its Season
+func (s Schedule) isSeasonal() bool {
+	return s.DateRange.IsZero() && s.Season != nil
+}
+
+// startBy is the first date of s which ByDate uses for limit
+//   - seasonal schedules begin with the latest season which starts by limit
+//   - open start schedules begin on Jan 1 of the year they end, or of limit when it is earlier
+//
+// the zero Date is returned when s has no dates at all
+func (s Schedule) startBy(limit Date) Date {
+	switch {
+	case s.isSeasonal():
+		if start := s.Season.Starting(limit.Year()).From; !start.After(limit) {
+			return start
+		}
+		return s.Season.Starting(limit.Year() - 1).From
+	case s.DateRange.IsOpenStart():
+		end := MinDate(s.DateRange.Until, &limit)
+		return NewDate(end.Year(), time.January, 1)
+	}
+	return s.DateRange.From
+}
+
+// within is the dates of the schedule which are also in dr
+func (s Schedule) within(dr DateRange) (DateRangeSet, error) {
+	var window = s.DateRange.Merge(dr)
+	if s.isSeasonal() {
+		window = dr
+	}
+	if s.Season == nil {
+		return NewDateRangeSet(window), nil
+	}
+	return s.Season.Within(window)
 }
 
 func (s Schedule) HasTimeSlots() bool {
 	return len(s.TimeSlots) > 0
 }
 
+// Validate returns ValidationErrors with one error for each problem found
+//   - SeasonError when the Season is invalid
+//   - DateRangeError when the DateRange is invalid
+//   - TimeSlotError for each slot which is invalid or a duplicate of an earlier slot
+//   - TimeSlotConflict for each pair of overlapping timeslots
+//
+// slots which are invalid or duplicates are not also reported as conflicts
+// opts are used to validate the DateRange, which may be zero when there is a Season
+func (s Schedule) Validate(opts ...ValidateOption) error {
+	var errs ValidationErrors
+	if s.Season != nil {
+		if err := s.Season.Validate(); err != nil {
+			errs = append(errs, SeasonError{Season: *s.Season, Err: err})
+		}
+	}
+	if !s.isSeasonal() {
+		if err := s.DateRange.Validate(opts...); err != nil {
+			errs = append(errs, DateRangeError{DateRange: s.DateRange, Err: err})
+		}
+	}
+
+	var (
+		slots = make([]WeekdayTimeSlot, 0, len(s.TimeSlots))
+		seen  = make(map[int]bool, len(s.TimeSlots))
+	)
+	for i, slot := range s.TimeSlots {
+		if err := slot.Validate(); err != nil {
+			errs = append(errs, TimeSlotError{Index: i, Slot: slot, Err: err})
+			continue
+		}
+		if seen[slot.ToInt()] {
+			errs = append(errs, TimeSlotError{Index: i, Slot: slot, Err: ErrDuplicateTimeSlot})
+			continue
+		}
+		seen[slot.ToInt()] = true
+		slots = append(slots, slot)
+	}
+
+	for _, conflict := range FindTimeSlotConflicts(slots...) {
+		errs = append(errs, conflict)
+	}
+	return errs.Err()
+}
+
+// TotalDuration is the length of every slot on every date where the schedule
+// and dr overlap, each slot counts once per weekday in range and lasts its full
+// length, which is 24 hours when all day, even when it crosses midnight past dr
+// the slots are made unique the same way ByDate does, so an all day slot means
+// "any" and is dropped when the same day has specific slots, other slots which
+// overlap each other are counted more than once, see Validate
+// Holidays are checked one date at a time so they make it O(days)
+// ErrInfiniteDateRange is returned when the overlap has no end
+func (s Schedule) TotalDuration(dr DateRange) (time.Duration, error) {
+	dates, err := s.within(dr)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		total time.Duration
+		slots = UniqueWeekdayTimeSlots(s.TimeSlots...)
+	)
+	for _, dr := range dates.ranges {
+		counts, err := dr.WeekdayCounts()
+		if err != nil {
+			return 0, err
+		}
+		for _, slot := range slots {
+			total += time.Duration(counts[slot.Weekday()]) * slot.weekDuration()
+		}
+		if s.Holidays == nil {
+			continue
+		}
+		var holidays = holidaysWithin(s.Holidays, dr)
+		_ = dr.Days(func(date Date) bool {
+			if !holidays.IsHoliday(date) {
+				return true
+			}
+			for _, slot := range slots {
+				if slot.Weekday() == date.Weekday() {
+					total -= slot.weekDuration()
+				}
+			}
+			return true
+		})
+	}
+	return total, nil
+}
+
 // Merge does a merge on both the schedule dateRanges and the timeslots
 //
 //	The intended use for this is to merge a parent schedule with a sub schedule
@@ -67,12 +213,16 @@ func (s Schedule) HasTimeSlots() bool {
 //	      Tues809,Tues6-7 were excluded because they only exist in one schedule
 //	      Wed7-8 was included because the other schedule was for Wed all day
 //
+// the Season and Holidays of s are kept, those of the other schedules are not merged
+//
 // see TestSchedulesMerge for a good example
 func (s Schedule) Merge(schedules ...Schedule) Schedule {
 	if len(schedules) == 0 {
 		return s
 	}
 	ret := NewSchedule(s.DateRange, s.TimeSlots...)
+	ret.Season = s.Season
+	ret.Holidays = s.Holidays
 
 	for _, schedule := range schedules {
 		ret.DateRange = ret.DateRange.Merge(schedule.DateRange)
@@ -105,8 +255,17 @@ func (cm CalendarMap) GetTimeslots() []WeekdayTimeSlot {
 	return calendarTimeslots
 }
 
+// unique drops duplicate slots on each date, see UniqueWeekdayTimeSlots
+func (cm CalendarMap) unique() CalendarMap {
+	for date, slots := range cm {
+		cm[date] = UniqueWeekdayTimeSlots(slots...)
+	}
+	return cm
+}
+
 type Calendar struct {
 	schedules []Schedule
+	holidays  HolidayProvider
 }
 
 func NewCalendar(schedules ...Schedule) Calendar {
@@ -120,16 +279,59 @@ func (c Calendar) WithSchedules(schedules ...Schedule) Calendar {
 	return c
 }
 
+// WithHolidays closes every schedule of the Calendar on the holidays of p
+// schedules with their own Holidays are closed on those as well
+func (c Calendar) WithHolidays(p HolidayProvider) Calendar {
+	c.holidays = p
+	return c
+}
+
+// ByDate is every date from the start of each schedule up until limit
+// each schedule has its own window so the others never change its dates,
+// schedules without a From begin with their latest occurrence by limit,
+// see startBy, use ByDateRange to choose the start
 func (c Calendar) ByDate(limit Date) CalendarMap {
 	var cm = make(CalendarMap)
-
 	for _, s := range c.schedules {
-		var dr = s.DateRange
-		if dr.Until == nil || dr.Until.After(limit) {
-			dr.Until = &limit
+		start := s.startBy(limit)
+		if start.IsZero() || start.After(limit) {
+			continue
 		}
+		// the window has both ends so addSchedule has every date of it
+		c.addSchedule(cm, s, NewDateRangeUntil(start, &limit))
+	}
+	return cm.unique()
+}
+
+// ByDateRange is every date of each schedule which is within dr
+// holidays of the Calendar or of the schedule are left out
+// ErrInfiniteDateRange is returned when dr is open start or goes on forever
+func (c Calendar) ByDateRange(dr DateRange) (CalendarMap, error) {
+	if dr.IsZero() || dr.Until == nil || dr.IsOpenStart() {
+		return nil, ErrInfiniteDateRange
+	}
+
+	var cm = make(CalendarMap)
+	for _, s := range c.schedules {
+		c.addSchedule(cm, s, dr)
+	}
+	return cm.unique(), nil
+}
 
-		for date := dr.From; !date.After(*dr.Until); date = date.Next() {
+// addSchedule adds the slots of s on each of its dates within dr, which must have both ends
+// holidays of the Calendar or of the schedule are left out
+func (c Calendar) addSchedule(cm CalendarMap, s Schedule, dr DateRange) {
+	// the dates are within dr so neither returns ErrInfiniteDateRange
+	var (
+		dates, _  = s.within(dr)
+		holidays  = holidaysWithin(c.holidays, dr)
+		closedDay = holidaysWithin(s.Holidays, dr)
+	)
+	for _, window := range dates.ranges {
+		_ = window.Days(func(date Date) bool {
+			if isHoliday(holidays, date) || isHoliday(closedDay, date) {
+				return true
+			}
 			if cm[date] == nil {
 				cm[date] = make([]WeekdayTimeSlot, 0, len(s.TimeSlots))
 			}
@@ -138,13 +340,7 @@ func (c Calendar) ByDate(limit Date) CalendarMap {
 					cm[date] = append(cm[date], slot)
 				}
 			}
-		}
-	}
-
-	// ensure
-	for date, slots := range cm {
-		cm[date] = UniqueWeekdayTimeSlots(slots...)
+			return true
+		})
 	}
-
-	return cm
 }
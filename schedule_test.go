@@ -2,8 +2,10 @@ package schedule_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tempcke/schedule"
 )
 
@@ -169,4 +171,215 @@ func TestCalendar(t *testing.T) {
 		assert.Contains(t, byDate[day2], d2s7)
 		assert.Contains(t, byDate[day2], d2s8)
 	})
+
+	t.Run("open start", func(t *testing.T) {
+		var (
+			mon   = schedule.NewDate(2026, 10, 12)
+			sun   = schedule.NewDate(2026, 10, 18)
+			limit = sun.AddDate(0, 0, 7)
+
+			monSlot = schedule.WeekdayTimeSlotFromString("Monday 09:00-10:00")
+			friSlot = schedule.WeekdayTimeSlotFromString("Friday 09:00-10:00")
+
+			legacy  = schedule.NewSchedule(schedule.NewOpenStartDateRange(sun), monSlot)
+			current = schedule.NewSchedule(schedule.NewDateRangeUntil(mon, nil), friSlot)
+		)
+
+		// open start begins on Jan 1 of the year it ends
+		var jan1 = schedule.NewDate(2026, 1, 1)
+		byDate := schedule.NewCalendar(legacy).ByDate(sun)
+		assert.Len(t, byDate, sun.Sub(jan1)+1)
+		assert.True(t, byDate.HasDate(jan1))
+		assert.False(t, byDate.HasDate(jan1.AddDate(0, 0, -1)))
+		assert.Equal(t, []schedule.WeekdayTimeSlot{monSlot}, byDate[mon])
+
+		// or of limit when limit is earlier
+		byDate = schedule.NewCalendar(legacy.WithUntil(schedule.NewDate(2027, 3, 1))).ByDate(sun)
+		assert.Len(t, byDate, sun.Sub(jan1)+1)
+
+		byDate = schedule.NewCalendar(legacy, current).ByDate(limit)
+		assert.Len(t, byDate, limit.Sub(jan1)+1)
+		assert.Equal(t, []schedule.WeekdayTimeSlot{monSlot}, byDate[mon])
+		assert.Empty(t, byDate[mon.AddDate(0, 0, 7)])
+		assert.Equal(t, []schedule.WeekdayTimeSlot{friSlot}, byDate[mon.AddDate(0, 0, 11)])
+
+		// a schedule from an earlier year does not move the Jan 1 start
+		var (
+			may1    = schedule.NewDate(2020, 5, 1)
+			may7    = schedule.NewDate(2020, 5, 7)
+			old2020 = schedule.NewSchedule(schedule.NewDateRangeUntil(may1, &may7), friSlot)
+		)
+		byDate = schedule.NewCalendar(legacy, old2020).ByDate(sun)
+		assert.Len(t, byDate, sun.Sub(jan1)+1+7)
+		assert.False(t, byDate.HasDate(schedule.NewDate(2025, 12, 31)))
+
+		// ByDateRange chooses the start
+		byDate, err := schedule.NewCalendar(legacy, current).
+			ByDateRange(schedule.NewDateRangeUntil(mon.AddDate(0, 0, -7), &limit))
+		require.NoError(t, err)
+		assert.Len(t, byDate, 21)
+		assert.Equal(t, []schedule.WeekdayTimeSlot{monSlot}, byDate[mon.AddDate(0, 0, -7)])
+
+		_, err = schedule.NewCalendar(legacy).ByDateRange(schedule.NewOpenStartDateRange(limit))
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+		_, err = schedule.NewCalendar(legacy).ByDateRange(schedule.NewDateRangeUntil(mon, nil))
+		assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+	})
+}
+
+func TestSchedule_Validate(t *testing.T) {
+	var (
+		jan1    = schedule.NewDate(2020, 1, 1)
+		jan30   = schedule.NewDate(2020, 1, 30)
+		mon0607 = schedule.WeekdayTimeSlotFromString("Monday 06:00-07:00")
+		mon0630 = schedule.WeekdayTimeSlotFromString("Monday 06:30-07:30")
+		tue0809 = schedule.WeekdayTimeSlotFromString("Tuesday 08:00-09:00")
+	)
+
+	t.Run("valid", func(t *testing.T) {
+		s := schedule.NewSchedule(schedule.NewDateRangeUntil(jan1, &jan30), mon0607, tue0809)
+		assert.NoError(t, s.Validate())
+	})
+
+	t.Run("date range and conflicts", func(t *testing.T) {
+		s := schedule.NewSchedule(schedule.NewDateRangeUntil(jan30, &jan1), mon0607, mon0630, tue0809)
+		err := s.Validate()
+		require.Error(t, err)
+
+		var errs schedule.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		assert.ErrorIs(t, err, schedule.ErrPastUntil)
+		var drErr schedule.DateRangeError
+		require.ErrorAs(t, err, &drErr)
+		assert.ErrorIs(t, err, schedule.ErrTimeSlotConflict)
+
+		var conflict schedule.TimeSlotConflict
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, mon0607, conflict.A)
+		assert.Equal(t, mon0630, conflict.B)
+	})
+}
+
+func TestSchedule_Validate_timeSlotErrors(t *testing.T) {
+	var (
+		jan1      = schedule.NewDate(2020, 1, 1)
+		mon0607   = schedule.WeekdayTimeSlotFromString("Monday 06:00-07:00")
+		mon0630   = schedule.WeekdayTimeSlotFromString("Monday 06:30-07:30")
+		tue0909   = schedule.WeekdayTimeSlotFromString("Tuesday 09:00-09:00")
+		wedAllDay = schedule.WeekdayTimeSlotFromString("Wednesday")
+		badDay    = schedule.NewWeekdayTimeSlot(schedule.Weekday(9), schedule.ParseTimeSlot("09:00-10:00"))
+	)
+
+	s := schedule.NewSchedule(schedule.DateRange{},
+		mon0607, tue0909, wedAllDay, badDay, mon0607, mon0630)
+	err := s.Validate()
+	require.Error(t, err)
+
+	var errs schedule.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 5)
+
+	assert.Equal(t, schedule.DateRangeError{Err: schedule.ErrFromRequired}, errs[0])
+	assert.Equal(t, schedule.TimeSlotError{Index: 1, Slot: tue0909, Err: schedule.ErrZeroLengthSlot}, errs[1])
+	assert.Equal(t, schedule.TimeSlotError{Index: 3, Slot: badDay, Err: schedule.ErrInvalidWeekday}, errs[2])
+	assert.Equal(t, schedule.TimeSlotError{Index: 4, Slot: mon0607, Err: schedule.ErrDuplicateTimeSlot}, errs[3])
+	assert.Equal(t, schedule.TimeSlotConflict{A: mon0607, B: mon0630}, errs[4])
+
+	for _, target := range []error{
+		schedule.ErrFromRequired,
+		schedule.ErrZeroLengthSlot,
+		schedule.ErrInvalidWeekday,
+		schedule.ErrDuplicateTimeSlot,
+		schedule.ErrTimeSlotConflict,
+	} {
+		assert.ErrorIs(t, err, target)
+	}
+	assert.NotErrorIs(t, err, schedule.ErrPastUntil)
+
+	t.Run("error message", func(t *testing.T) {
+		assert.Equal(t, "timeslots[1] Tuesday 09:00-09:00: timeslot has no length", errs[1].Error())
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		s := schedule.NewSchedule(schedule.NewDateRangeUntil(jan1, nil), mon0607, wedAllDay)
+		assert.NoError(t, s.Validate())
+	})
+}
+
+func BenchmarkCalendar_ByDate(b *testing.B) {
+	var (
+		from  = schedule.NewDate(2020, 1, 1)
+		until = schedule.NewDate(2024, 12, 31)
+		limit = schedule.NewDate(2025, 12, 31)
+		slots = []schedule.WeekdayTimeSlot{
+			schedule.WeekdayTimeSlotFromString("Monday 08:00-12:00"),
+			schedule.WeekdayTimeSlotFromString("Monday 13:00-17:00"),
+			schedule.WeekdayTimeSlotFromString("Wednesday 08:00-12:00"),
+			schedule.WeekdayTimeSlotFromString("Friday 13:00-17:00"),
+			schedule.WeekdayTimeSlotFromString("Saturday"),
+		}
+		calendar = schedule.NewCalendar(
+			schedule.NewSchedule(schedule.NewDateRangeUntil(from, &until), slots...),
+			schedule.NewSchedule(schedule.NewDateRangeUntil(from.AddDate(1, 0, 0), nil), slots[1:3]...),
+		)
+	)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchInt = len(calendar.ByDate(limit))
+	}
+}
+
+func TestSchedule_TotalDuration(t *testing.T) {
+	var (
+		oct1  = schedule.NewDate(2026, 10, 1) // Thursday
+		oct31 = schedule.NewDate(2026, 10, 31)
+		oct   = schedule.NewDateRangeUntil(oct1, &oct31)
+
+		mon0917 = schedule.WeekdayTimeSlotFromString("Monday 09:00-17:00")
+		sat2202 = schedule.WeekdayTimeSlotFromString("Saturday 22:00-02:00")
+		sunDay  = schedule.WeekdayTimeSlotFromString("Sunday")
+
+		s = schedule.NewSchedule(schedule.NewDateRangeUntil(oct1, nil), mon0917, sat2202, sunDay, mon0917)
+	)
+
+	// October 2026 has 4 Mondays, 5 Saturdays and 4 Sundays
+	total, err := s.TotalDuration(oct)
+	require.NoError(t, err)
+	assert.Equal(t, 4*8*time.Hour+5*4*time.Hour+4*24*time.Hour, total)
+
+	// only where the schedule and range overlap
+	total, err = s.WithFrom(schedule.NewDate(2026, 10, 26)).TotalDuration(oct)
+	require.NoError(t, err)
+	assert.Equal(t, 8*time.Hour+4*time.Hour, total)
+
+	total, err = s.WithUntil(oct1.AddDate(0, 0, -1)).WithFrom(oct1.AddDate(0, -1, 0)).TotalDuration(oct)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), total)
+
+	_, err = s.TotalDuration(schedule.NewDateRangeUntil(oct1, nil))
+	assert.ErrorIs(t, err, schedule.ErrInfiniteDateRange)
+
+	// a bounded schedule with an open ended range is fine
+	total, err = s.WithUntil(oct31).TotalDuration(schedule.NewDateRangeUntil(oct1, nil))
+	require.NoError(t, err)
+	assert.Equal(t, 4*8*time.Hour+5*4*time.Hour+4*24*time.Hour, total)
+
+	// an all day slot is "any" and gives way to a specific slot on the same day, as in ByDate
+	var (
+		oct5   = schedule.NewDate(2026, 10, 5) // Monday
+		week   = schedule.NewDateRangeUntil(oct5, oct5.AddDate(0, 0, 6).Pointer())
+		monDay = schedule.WeekdayTimeSlotFromString("Monday")
+		mon910 = schedule.WeekdayTimeSlotFromString("Monday 09:00-10:00")
+	)
+	total, err = schedule.NewSchedule(week, monDay, mon910).TotalDuration(week)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, total)
+	byDate, err := schedule.NewCalendar(schedule.NewSchedule(week, monDay, mon910)).ByDateRange(week)
+	require.NoError(t, err)
+	assert.Equal(t, []schedule.WeekdayTimeSlot{mon910}, byDate[oct5])
+	total, err = schedule.NewSchedule(week, monDay).TotalDuration(week)
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, total)
 }
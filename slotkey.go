@@ -0,0 +1,119 @@
+package schedule
+
+import "fmt"
+
+// Slot key versions
+//
+//	SlotKeyV0 is ToInt: 3 bits weekday, 11 bits start minute, 11 bits end minute
+//	SlotKeyV1 is ToKey: see the layout below
+const (
+	SlotKeyV0 = 0
+	SlotKeyV1 = 1
+)
+
+// SlotKeyV1 layout, bit 63 is never used so keys are always positive
+//
+//	bits 56-62 version
+//	bits 45-55 reserved
+//	bits 42-44 weekday
+//	bits 25-41 start, seconds since 00:00
+//	bits 08-24 end, seconds since 00:00, so 24:00 (86400) fits
+//	bits 00-07 flags
+//
+// the weekday, start and end are in the same order as the old key
+// so sorting by either key gives the same order
+const (
+	slotKeyVersionShift = 56
+	slotKeyDayShift     = 42
+	slotKeyStartShift   = 25
+	slotKeyEndShift     = 8
+
+	slotKeySecondsMask  = 1<<17 - 1
+	slotKeyReservedMask = (1<<11 - 1) << 45
+	slotKeyV0Max        = 1<<25 - 1
+	slotKeyV0Minutes    = 1<<11 - 1
+
+	secondsPerDay = 24 * 60 * 60
+)
+
+// Slot key flags
+const (
+	SlotKeyFlagAllDay int64 = 1 << iota
+)
+
+// ToKey is a versioned int64 key which is stable and unique for the slot
+// unlike ToInt it has room for seconds and flags, use it for new storage
+func (s WeekdayTimeSlot) ToKey() int64 {
+	var flags int64
+	if s.IsAllDay() {
+		flags |= SlotKeyFlagAllDay
+	}
+	return SlotKeyV1<<slotKeyVersionShift |
+		int64(s.day)<<slotKeyDayShift |
+		int64(s.Start().min*60)<<slotKeyStartShift |
+		int64(s.End().min*60)<<slotKeyEndShift |
+		flags
+}
+
+// SlotKeyVersion returns the version of a key made by ToInt or ToKey
+func SlotKeyVersion(key int64) int {
+	return int(key >> slotKeyVersionShift)
+}
+
+// WeekdayTimeSlotFromKey decodes keys made by either ToInt or ToKey
+// seconds are dropped because a Clock has minutes only
+// keys with a weekday or time out of range, or reserved bits set, are invalid
+func WeekdayTimeSlotFromKey(key int64) (WeekdayTimeSlot, error) {
+	if key < 0 {
+		return WeekdayTimeSlot{}, fmt.Errorf("%w: %d", ErrInvalidSlotKey, key)
+	}
+
+	switch SlotKeyVersion(key) {
+	case SlotKeyV0:
+		var (
+			day   = Weekday(key >> 22)
+			start = int(key >> 11 & slotKeyV0Minutes)
+			end   = int(key & slotKeyV0Minutes)
+		)
+		if key > slotKeyV0Max || start >= minutesPerDay || end >= minutesPerDay || !day.IsValid() {
+			return WeekdayTimeSlot{}, fmt.Errorf("%w: %d", ErrInvalidSlotKey, key)
+		}
+		return WeekdayTimeSlotFromInt(int(key)), nil
+	case SlotKeyV1:
+		var (
+			day   = Weekday(key >> slotKeyDayShift & 0b111)
+			start = int(key >> slotKeyStartShift & slotKeySecondsMask)
+			end   = int(key >> slotKeyEndShift & slotKeySecondsMask)
+		)
+		if start > secondsPerDay || end > secondsPerDay || !day.IsValid() || key&slotKeyReservedMask != 0 {
+			return WeekdayTimeSlot{}, fmt.Errorf("%w: %d", ErrInvalidSlotKey, key)
+		}
+		slot := NewTimeSlot(NewClock(0, start/60), NewClock(0, end/60))
+		return NewWeekdayTimeSlot(day, slot), nil
+	default:
+		return WeekdayTimeSlot{}, fmt.Errorf("%w: unknown version %d", ErrInvalidSlotKey, SlotKeyVersion(key))
+	}
+}
+
+// MigrateSlotKey converts a key from ToInt into a key from ToKey
+// keys which are already the newest version are returned as is
+func MigrateSlotKey(key int64) (int64, error) {
+	slot, err := WeekdayTimeSlotFromKey(key)
+	if err != nil {
+		return 0, err
+	}
+	return slot.ToKey(), nil
+}
+
+// MigrateSlotKeys converts stored keys, it stops at the first invalid key
+func MigrateSlotKeys(keys ...int64) ([]int64, error) {
+	var migrated = make([]int64, len(keys))
+	for i, key := range keys {
+		newKey, err := MigrateSlotKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("keys[%d]: %w", i, err)
+		}
+		migrated[i] = newKey
+	}
+	return migrated, nil
+}
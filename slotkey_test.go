@@ -0,0 +1,92 @@
+package schedule_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestWeekdayTimeSlot_ToKey(t *testing.T) {
+	slots := []schedule.WeekdayTimeSlot{
+		schedule.WeekdayTimeSlotFromString("Sunday"),
+		schedule.WeekdayTimeSlotFromString("Monday 07:00-08:00"),
+		schedule.WeekdayTimeSlotFromString("Monday 07:00-08:30"),
+		schedule.WeekdayTimeSlotFromString("Monday 23:30-00:30"),
+		schedule.WeekdayTimeSlotFromString("Saturday 23:59-00:00"),
+	}
+
+	for i, slot := range slots {
+		t.Run(slot.String(), func(t *testing.T) {
+			key := slot.ToKey()
+			assert.Greater(t, key, int64(0))
+			assert.Equal(t, schedule.SlotKeyV1, schedule.SlotKeyVersion(key))
+
+			decoded, err := schedule.WeekdayTimeSlotFromKey(key)
+			require.NoError(t, err)
+			assert.Equal(t, slot, decoded)
+
+			// old keys still decode
+			assert.Equal(t, schedule.SlotKeyV0, schedule.SlotKeyVersion(int64(slot.ToInt())))
+			decoded, err = schedule.WeekdayTimeSlotFromKey(int64(slot.ToInt()))
+			require.NoError(t, err)
+			assert.Equal(t, slot, decoded)
+
+			// new keys sort the same as old keys
+			if i > 0 {
+				assert.Less(t, slots[i-1].ToKey(), key)
+				assert.Less(t, slots[i-1].ToInt(), slot.ToInt())
+			}
+		})
+	}
+
+	t.Run("all day flag", func(t *testing.T) {
+		assert.NotZero(t, slots[0].ToKey()&schedule.SlotKeyFlagAllDay)
+		assert.Zero(t, slots[1].ToKey()&schedule.SlotKeyFlagAllDay)
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		for _, key := range []int64{
+			-1, 1 << 30, 2 << 56, 1<<56 | 90000<<25,
+			7<<22 | 60<<11 | 120,                       // v0 weekday 7
+			1<<22 | 2000<<11 | 120,                     // v0 start past 24:00
+			1<<22 | 60<<11 | 1440,                      // v0 end of 24:00
+			1<<56 | 1<<45 | 1<<42 | 3600<<25 | 7200<<8, // v1 reserved bit
+		} {
+			_, err := schedule.WeekdayTimeSlotFromKey(key)
+			assert.ErrorIs(t, err, schedule.ErrInvalidSlotKey, key)
+		}
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		oldKeys := make([]int64, len(slots))
+		for i, slot := range slots {
+			oldKeys[i] = int64(slot.ToInt())
+		}
+		newKeys, err := schedule.MigrateSlotKeys(oldKeys...)
+		require.NoError(t, err)
+		for i, slot := range slots {
+			assert.Equal(t, slot.ToKey(), newKeys[i])
+		}
+
+		// migrating twice changes nothing
+		again, err := schedule.MigrateSlotKeys(newKeys...)
+		require.NoError(t, err)
+		assert.Equal(t, newKeys, again)
+
+		_, err = schedule.MigrateSlotKeys(oldKeys[0], -1)
+		assert.ErrorIs(t, err, schedule.ErrInvalidSlotKey)
+	})
+
+	t.Run("scan either key", func(t *testing.T) {
+		slot := slots[3]
+		for _, src := range []interface{}{slot.ToInt(), int64(slot.ToInt()), slot.ToKey()} {
+			var wts schedule.WeekdayTimeSlot
+			require.NoError(t, wts.Scan(src))
+			assert.Equal(t, slot, wts)
+		}
+		var wts schedule.WeekdayTimeSlot
+		assert.Error(t, wts.Scan(int64(-1)))
+	})
+}
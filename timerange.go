@@ -0,0 +1,203 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// TimeRange is the instants from Start until End, including Start but not End
+// unlike TimeSlot it is real time so it is the same moment in every location,
+// use it to compare schedules with booked appointments
+type TimeRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+func NewTimeRange(start, end time.Time) TimeRange {
+	return TimeRange{Start: start, End: end}
+}
+
+func (r TimeRange) String() string {
+	return r.Start.Format(time.RFC3339) + "/" + r.End.Format(time.RFC3339)
+}
+
+// IsEmpty is true when the range has no length, or End is before Start
+func (r TimeRange) IsEmpty() bool { return !r.End.After(r.Start) }
+
+// Equal compares instants so the location of the times does not matter
+func (r TimeRange) Equal(r2 TimeRange) bool {
+	return r.Start.Equal(r2.Start) && r.End.Equal(r2.End)
+}
+
+// Duration is the length of the range, never negative
+func (r TimeRange) Duration() time.Duration {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.End.Sub(r.Start)
+}
+
+// Contains is true when t is at or after Start and before End
+func (r TimeRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// Overlaps is true when any instant is in both ranges
+// ranges which only touch, one ending as the other starts, do not overlap
+func (r TimeRange) Overlaps(r2 TimeRange) bool {
+	return !r.IsEmpty() && !r2.IsEmpty() &&
+		r.Start.Before(r2.End) && r2.Start.Before(r.End)
+}
+
+// Intersect is the part of the ranges which overlap
+// false is returned when they do not overlap
+func (r TimeRange) Intersect(r2 TimeRange) (TimeRange, bool) {
+	if !r.Overlaps(r2) {
+		return TimeRange{}, false
+	}
+	return NewTimeRange(maxTime(r.Start, r2.Start), minTime(r.End, r2.End)), true
+}
+
+// Union is a single range when the ranges overlap or touch
+// otherwise it is both ranges sorted by Start, empty ranges are dropped
+func (r TimeRange) Union(r2 TimeRange) []TimeRange {
+	return MergeTimeRanges(r, r2)
+}
+
+// Subtract removes r2 from r leaving zero, one or two ranges
+//
+//	09:00-17:00 subtract 12:00-13:00 = 09:00-12:00, 13:00-17:00
+func (r TimeRange) Subtract(r2 TimeRange) []TimeRange {
+	if r.IsEmpty() {
+		return nil
+	}
+	if !r.Overlaps(r2) {
+		return []TimeRange{r}
+	}
+	var ranges []TimeRange
+	if r.Start.Before(r2.Start) {
+		ranges = append(ranges, NewTimeRange(r.Start, r2.Start))
+	}
+	if r2.End.Before(r.End) {
+		ranges = append(ranges, NewTimeRange(r2.End, r.End))
+	}
+	return ranges
+}
+
+// SplitAtMidnight splits the range at each midnight in loc
+// so every range returned is within a single date in loc
+func (r TimeRange) SplitAtMidnight(loc *time.Location) []TimeRange {
+	if r.IsEmpty() {
+		return nil
+	}
+	var (
+		ranges []TimeRange
+		start  = r.Start
+	)
+	for start.Before(r.End) {
+		midnight := Clock{}.ToTime(NewDateFromTimeIn(start, loc).Next(), loc)
+		if !midnight.Before(r.End) {
+			break
+		}
+		ranges = append(ranges, NewTimeRange(start, midnight))
+		start = midnight
+	}
+	return append(ranges, NewTimeRange(start, r.End))
+}
+
+// MergeTimeRanges sorts by Start and joins ranges which overlap or touch
+// empty ranges are dropped
+func MergeTimeRanges(ranges ...TimeRange) []TimeRange {
+	var sorted = make([]TimeRange, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	SortTimeRanges(sorted)
+
+	var merged = make([]TimeRange, 0, len(sorted))
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && !r.Start.After(merged[n-1].End) {
+			merged[n-1].End = maxTime(merged[n-1].End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// SubtractTimeRanges removes every range in b from the ranges in a
+// the result is merged, such as free time being open hours minus bookings
+func SubtractTimeRanges(a, b []TimeRange) []TimeRange {
+	var (
+		result = MergeTimeRanges(a...)
+		remove = MergeTimeRanges(b...)
+	)
+	for _, r2 := range remove {
+		var next = make([]TimeRange, 0, len(result)+1)
+		for _, r := range result {
+			next = append(next, r.Subtract(r2)...)
+		}
+		result = next
+	}
+	return result
+}
+
+// SortTimeRanges sorts in place by Start then End
+func SortTimeRanges(ranges []TimeRange) {
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].Start.Equal(ranges[j].Start) {
+			return ranges[i].End.Before(ranges[j].End)
+		}
+		return ranges[i].Start.Before(ranges[j].Start)
+	})
+}
+
+// TimeRangeOn is when the slot happens on date in loc, the weekday of
+// date is not checked, an all day slot or one crossing midnight ends on the
+// next date, daylight saving changes are handled the same way time.Date does
+func (s WeekdayTimeSlot) TimeRangeOn(date Date, loc *time.Location) TimeRange {
+	var endDate = date
+	if s.IsAllDay() || s.crossesMidnight() {
+		endDate = date.Next()
+	}
+	return NewTimeRange(s.Start().ToTime(date, loc), s.End().ToTime(endDate, loc))
+}
+
+// TimeRanges is every slot of every date in loc sorted by Start
+// ranges are not merged, each one is a single occurrence of a slot
+func (cm CalendarMap) TimeRanges(loc *time.Location) []TimeRange {
+	var ranges = make([]TimeRange, 0, len(cm))
+	for date, slots := range cm {
+		for _, slot := range slots {
+			ranges = append(ranges, slot.TimeRangeOn(date, loc))
+		}
+	}
+	SortTimeRanges(ranges)
+	return ranges
+}
+
+// TimeRanges is every occurrence up until limit in loc, see Calendar.ByDate
+func (c Calendar) TimeRanges(limit Date, loc *time.Location) []TimeRange {
+	return c.ByDate(limit).TimeRanges(loc)
+}
+
+// TimeRanges is every occurrence up until limit in loc, see Calendar.ByDate
+func (s Schedule) TimeRanges(limit Date, loc *time.Location) []TimeRange {
+	return NewCalendar(s).TimeRanges(limit, loc)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,139 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestTimeRange(t *testing.T) {
+	var (
+		at = func(h, m int) time.Time { return time.Date(2026, 10, 17, h, m, 0, 0, time.UTC) }
+		tr = func(h1, h2 int) schedule.TimeRange { return schedule.NewTimeRange(at(h1, 0), at(h2, 0)) }
+
+		nineToFive = tr(9, 17)
+		lunch      = tr(12, 13)
+	)
+
+	t.Run("Duration Contains IsEmpty", func(t *testing.T) {
+		assert.Equal(t, 8*time.Hour, nineToFive.Duration())
+		assert.Equal(t, time.Duration(0), tr(10, 9).Duration())
+		assert.True(t, tr(10, 9).IsEmpty())
+		assert.True(t, tr(9, 9).IsEmpty())
+		assert.True(t, nineToFive.Contains(at(9, 0)))
+		assert.True(t, nineToFive.Contains(at(16, 59)))
+		assert.False(t, nineToFive.Contains(at(17, 0)))
+		assert.False(t, nineToFive.Contains(at(8, 59)))
+	})
+
+	t.Run("Overlaps Intersect", func(t *testing.T) {
+		var tests = map[string]struct {
+			a, b      schedule.TimeRange
+			overlaps  bool
+			intersect schedule.TimeRange
+		}{
+			"inside":     {nineToFive, lunch, true, lunch},
+			"partial":    {tr(9, 12), tr(11, 14), true, tr(11, 12)},
+			"touching":   {tr(9, 12), tr(12, 14), false, schedule.TimeRange{}},
+			"apart":      {tr(9, 10), tr(11, 12), false, schedule.TimeRange{}},
+			"empty":      {nineToFive, tr(10, 10), false, schedule.TimeRange{}},
+			"same range": {lunch, lunch, true, lunch},
+		}
+		for name, tc := range tests {
+			assert.Equal(t, tc.overlaps, tc.a.Overlaps(tc.b), name)
+			assert.Equal(t, tc.overlaps, tc.b.Overlaps(tc.a), name)
+			got, ok := tc.a.Intersect(tc.b)
+			assert.Equal(t, tc.overlaps, ok, name)
+			assert.True(t, tc.intersect.Equal(got), name)
+		}
+	})
+
+	t.Run("Union Subtract", func(t *testing.T) {
+		assert.Equal(t, []schedule.TimeRange{tr(9, 14)}, tr(9, 12).Union(tr(12, 14)))
+		assert.Equal(t, []schedule.TimeRange{tr(9, 10), tr(11, 12)}, tr(11, 12).Union(tr(9, 10)))
+
+		assert.Equal(t, []schedule.TimeRange{tr(9, 12), tr(13, 17)}, nineToFive.Subtract(lunch))
+		assert.Equal(t, []schedule.TimeRange{tr(13, 17)}, nineToFive.Subtract(tr(8, 13)))
+		assert.Equal(t, []schedule.TimeRange{tr(9, 12)}, nineToFive.Subtract(tr(12, 18)))
+		assert.Empty(t, lunch.Subtract(nineToFive))
+		assert.Equal(t, []schedule.TimeRange{lunch}, lunch.Subtract(tr(13, 14)))
+	})
+
+	t.Run("MergeTimeRanges SubtractTimeRanges", func(t *testing.T) {
+		merged := schedule.MergeTimeRanges(tr(15, 16), tr(9, 10), tr(10, 11), tr(9, 9), tr(14, 17))
+		assert.Equal(t, []schedule.TimeRange{tr(9, 11), tr(14, 17)}, merged)
+
+		open := []schedule.TimeRange{tr(9, 12), tr(13, 17)}
+		booked := []schedule.TimeRange{tr(10, 11), tr(11, 12), tr(16, 18)}
+		assert.Equal(t,
+			[]schedule.TimeRange{tr(9, 10), tr(13, 16)},
+			schedule.SubtractTimeRanges(open, booked))
+	})
+
+	t.Run("SplitAtMidnight", func(t *testing.T) {
+		var (
+			ny    = time.FixedZone("New York", -4*60*60)
+			start = time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC) // 18:00 in New York
+			r     = schedule.NewTimeRange(start, start.Add(30*time.Hour))
+		)
+		utc := r.SplitAtMidnight(time.UTC)
+		require.Len(t, utc, 3)
+		assert.Equal(t, 2*time.Hour, utc[0].Duration())
+		assert.Equal(t, 24*time.Hour, utc[1].Duration())
+		assert.Equal(t, 4*time.Hour, utc[2].Duration())
+
+		local := r.SplitAtMidnight(ny)
+		require.Len(t, local, 2)
+		assert.Equal(t, 6*time.Hour, local[0].Duration())
+		assert.Equal(t, 24*time.Hour, local[1].Duration())
+
+		assert.Equal(t, []schedule.TimeRange{lunch}, lunch.SplitAtMidnight(time.UTC))
+		assert.Empty(t, tr(10, 9).SplitAtMidnight(time.UTC))
+	})
+}
+
+func TestTimeRange_fromSchedule(t *testing.T) {
+	var (
+		loc    = time.FixedZone("Test", 2*60*60)
+		sat    = schedule.NewDate(2026, 10, 17)
+		limit  = sat.AddDate(0, 0, 7)
+		mon    = schedule.WeekdayTimeSlotFromString("Monday 09:00-12:00")
+		sat23  = schedule.WeekdayTimeSlotFromString("Saturday 23:00-01:00")
+		sunDay = schedule.WeekdayTimeSlotFromString("Sunday")
+		s      = schedule.NewSchedule(schedule.NewDateRangeUntil(sat, &limit), mon, sat23, sunDay)
+		local  = func(d schedule.Date, h int) time.Time { return schedule.NewClock(h, 0).ToTime(d, loc) }
+	)
+
+	t.Run("TimeRangeOn", func(t *testing.T) {
+		assert.Equal(t,
+			schedule.NewTimeRange(local(sat, 23), local(sat.Next(), 1)),
+			sat23.TimeRangeOn(sat, loc))
+		assert.Equal(t, 24*time.Hour, sunDay.TimeRangeOn(sat.Next(), loc).Duration())
+	})
+
+	t.Run("Schedule.TimeRanges", func(t *testing.T) {
+		var (
+			sun  = sat.Next()
+			mon1 = sun.Next()
+		)
+		assert.Equal(t, []schedule.TimeRange{
+			schedule.NewTimeRange(local(sat, 23), local(sun, 1)),
+			schedule.NewTimeRange(local(sun, 0), local(mon1, 0)),
+			schedule.NewTimeRange(local(mon1, 9), local(mon1, 12)),
+			schedule.NewTimeRange(local(limit, 23), local(limit.Next(), 1)),
+		}, s.TimeRanges(limit, loc))
+
+		// open time is the schedule minus booked appointments
+		booked := schedule.NewTimeRange(local(mon1, 10), local(mon1, 11))
+		free := schedule.SubtractTimeRanges(s.TimeRanges(limit, loc), []schedule.TimeRange{booked})
+		assert.Equal(t, []schedule.TimeRange{
+			schedule.NewTimeRange(local(sat, 23), local(mon1, 0)),
+			schedule.NewTimeRange(local(mon1, 9), local(mon1, 10)),
+			schedule.NewTimeRange(local(mon1, 11), local(mon1, 12)),
+			schedule.NewTimeRange(local(limit, 23), local(limit.Next(), 1)),
+		}, free)
+	})
+}
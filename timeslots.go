@@ -45,6 +45,11 @@ func (w WeekdayTimeSlotMap) TimeSlots(day Weekday) []TimeSlot {
 	return w[day]
 }
 
+// Conflicts returns every pair of overlapping slots in the map
+func (w WeekdayTimeSlotMap) Conflicts() []TimeSlotConflict {
+	return FindTimeSlotConflicts(w.ToWeekdayTimeSlots()...)
+}
+
 func (w WeekdayTimeSlotMap) ToWeekdayTimeSlots() []WeekdayTimeSlot {
 	wtsSlice := make([]WeekdayTimeSlot, 0)
 	for day, slots := range w {
@@ -129,6 +134,13 @@ func NewWeekdayAllDayTimeSlot(day Weekday) WeekdayTimeSlot {
 	return WeekdayTimeSlot{day: day, slot: slot}
 }
 
+// NewWeekdayTimeSlotBetween is the slot from start until end
+// Monday 00:00 until Tuesday 00:00 is an all day Monday slot,
+// a slot can not last longer than a day so only the clock of end is used
+func NewWeekdayTimeSlotBetween(start, end WeekClock) WeekdayTimeSlot {
+	return NewWeekdayTimeSlot(start.Weekday(), NewTimeSlot(start.Clock(), end.Clock()))
+}
+
 func WeekdayTimeSlotFromString(wtsString string) WeekdayTimeSlot {
 	var wts WeekdayTimeSlot
 
@@ -181,6 +193,7 @@ func WeekdayTimeSlotFromInt(wtsInt int) WeekdayTimeSlot {
 // ToInt stores the object in binary
 // 3 bits for day, 11 bits for start, 11 bits for end
 // this could be used to check equality or sorting
+// for a stored key prefer ToKey which is versioned and has room to grow
 func (s WeekdayTimeSlot) ToInt() int {
 	dayInt := int(s.day) << 22
 	startInt := s.Start().min << 11
@@ -196,28 +209,78 @@ func (s WeekdayTimeSlot) IsAllDay() bool {
 	return s.slot.IsZero()
 }
 
+// Validate checks the weekday is valid and that the slot has a length
+// an all day slot (00:00-00:00) is the only slot allowed to start and end at the same time
+func (s WeekdayTimeSlot) Validate() error {
+	if !s.day.IsValid() {
+		return ErrInvalidWeekday
+	}
+	if !s.IsAllDay() && s.Start().Equal(s.End()) {
+		return ErrZeroLengthSlot
+	}
+	return nil
+}
+
+// OverlapsWith is true when either slot starts while the other is in progress
 func (s WeekdayTimeSlot) OverlapsWith(wts2 WeekdayTimeSlot) bool {
-	slots := SortWeekdayTimeSlots(s, wts2)
-	s0, s1 := slots[0], slots[1]
+	return s.Contains(wts2.StartWeekClock()) || wts2.Contains(s.StartWeekClock())
+}
 
-	if s0.Weekday() == s1.Weekday() {
-		return s0.IsAllDay() || s1.Start().Before(s0.End()) || s0.crossesMidnight()
-	}
+// Contains is true when wc is at or after the start and before the end
+func (s WeekdayTimeSlot) Contains(wc WeekClock) bool {
+	return wc.Sub(s.StartWeekClock()) < s.weekDuration()
+}
 
-	if s0.crossesMidnight() && s1.Weekday() == s0.Weekday().Next() {
-		// example: Monday 23:30-00:30, Tuesday 00:15-01:15
-		return s1.Start().Before(s0.End())
-	}
+// StartWeekClock is when the slot starts within the week
+func (s WeekdayTimeSlot) StartWeekClock() WeekClock {
+	return NewWeekClock(s.day, s.Start())
+}
 
-	if s1.Weekday() == Saturday && s0.Weekday() == Sunday {
-		// example: Sunday 00:15-01:15, Saturday 23:30-00:30
-		return s0.Start().Before(s1.End()) && s1.crossesMidnight()
+// EndWeekClock is when the slot ends within the week
+// which is on the next weekday when the slot is all day or crosses midnight
+func (s WeekdayTimeSlot) EndWeekClock() WeekClock {
+	return s.StartWeekClock().Add(s.weekDuration())
+}
+
+// weekDuration is the real length of the slot, unlike Duration
+// it is a full day for all day slots and positive when crossing midnight
+func (s WeekdayTimeSlot) weekDuration() time.Duration {
+	mins := s.Minutes()
+	if s.IsAllDay() || s.crossesMidnight() {
+		mins += minutesPerDay
 	}
-	return false
+	return time.Duration(mins) * time.Minute
 }
 
 func (s WeekdayTimeSlot) crossesMidnight() bool { return s.End().Before(s.Start()) }
 
+// TimeSlotConflict is a pair of WeekdayTimeSlot's which overlap
+type TimeSlotConflict struct {
+	A WeekdayTimeSlot
+	B WeekdayTimeSlot
+}
+
+func (c TimeSlotConflict) Error() string {
+	return ErrTimeSlotConflict.Error() + ": " + c.A.String() + " and " + c.B.String()
+}
+
+func (c TimeSlotConflict) Unwrap() error { return ErrTimeSlotConflict }
+
+// FindTimeSlotConflicts returns every pair of slots which overlap
+// this includes slots crossing midnight (even from Saturday into Sunday)
+// and all day slots, pairs are returned in the order the slots were given
+func FindTimeSlotConflicts(slots ...WeekdayTimeSlot) []TimeSlotConflict {
+	var conflicts []TimeSlotConflict
+	for i := range slots {
+		for j := i + 1; j < len(slots); j++ {
+			if slots[i].OverlapsWith(slots[j]) {
+				conflicts = append(conflicts, TimeSlotConflict{A: slots[i], B: slots[j]})
+			}
+		}
+	}
+	return conflicts
+}
+
 func (s WeekdayTimeSlot) Equal(s2 WeekdayTimeSlot) bool {
 	return s.ToInt() == s2.ToInt()
 }
@@ -422,3 +422,40 @@ func toJsonString(t *testing.T, object interface{}) string {
 	assert.NotEmpty(t, jsonBytes)
 	return string(jsonBytes)
 }
+
+func TestFindTimeSlotConflicts(t *testing.T) {
+	var (
+		mon0607    = schedule.WeekdayTimeSlotFromString("Monday 06:00-07:00")
+		mon0630    = schedule.WeekdayTimeSlotFromString("Monday 06:30-07:30")
+		mon0800    = schedule.WeekdayTimeSlotFromString("Monday 08:00-09:00")
+		tueAllDay  = schedule.WeekdayTimeSlotFromString("Tuesday")
+		tue0809    = schedule.WeekdayTimeSlotFromString("Tuesday 08:00-09:00")
+		sat2301    = schedule.WeekdayTimeSlotFromString("Saturday 23:00-01:00")
+		sun0001    = schedule.WeekdayTimeSlotFromString("Sunday 00:30-01:30")
+		wed0910    = schedule.WeekdayTimeSlotFromString("Wednesday 09:00-10:00")
+		thu0910    = schedule.WeekdayTimeSlotFromString("Thursday 09:00-10:00")
+		noConflict = []schedule.WeekdayTimeSlot{mon0607, mon0800, wed0910, thu0910}
+	)
+
+	t.Run("no conflicts", func(t *testing.T) {
+		assert.Empty(t, schedule.FindTimeSlotConflicts(noConflict...))
+	})
+
+	t.Run("every conflicting pair", func(t *testing.T) {
+		conflicts := schedule.FindTimeSlotConflicts(
+			mon0607, mon0630, mon0800, tueAllDay, tue0809, sat2301, sun0001, wed0910)
+		require.Len(t, conflicts, 3)
+		assert.Equal(t, schedule.TimeSlotConflict{A: mon0607, B: mon0630}, conflicts[0])
+		assert.Equal(t, schedule.TimeSlotConflict{A: tueAllDay, B: tue0809}, conflicts[1])
+		assert.Equal(t, schedule.TimeSlotConflict{A: sat2301, B: sun0001}, conflicts[2])
+		assert.ErrorIs(t, conflicts[0], schedule.ErrTimeSlotConflict)
+	})
+
+	t.Run("WeekdayTimeSlotMap", func(t *testing.T) {
+		wtsMap := schedule.WeekdayTimeSlotMapFromSlice(
+			[]schedule.WeekdayTimeSlot{sat2301, sun0001, wed0910})
+		conflicts := wtsMap.Conflicts()
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, schedule.TimeSlotConflict{A: sun0001, B: sat2301}, conflicts[0])
+	})
+}
@@ -0,0 +1,135 @@
+package schedule
+
+import (
+	"math/bits"
+	"time"
+)
+
+const weekBitmapWords = (minutesPerWeek + 63) / 64
+
+// lastWordMask keeps only the bits of the final word which are within the week
+const lastWordMask = 1<<(minutesPerWeek%64) - 1
+
+// WeekBitmap has one bit for each minute of the week, starting Sunday 00:00
+// it is a compiled form of []WeekdayTimeSlot for when the same slots are
+// checked over and over, Contains is O(1) and And, Or, Not work a word at a time
+type WeekBitmap struct {
+	words [weekBitmapWords]uint64
+}
+
+// CompileWeekdayTimeSlots sets the minutes of every slot
+// slots crossing midnight on Saturday wrap around into Sunday
+func CompileWeekdayTimeSlots(slots ...WeekdayTimeSlot) WeekBitmap {
+	var b WeekBitmap
+	for _, slot := range slots {
+		b.setRange(slot.StartWeekClock().min, int(slot.weekDuration()/time.Minute))
+	}
+	return b
+}
+
+// setRange sets n minutes starting at minute from, wrapping past the end of the week
+func (b *WeekBitmap) setRange(from, n int) {
+	for i := 0; i < n; i++ {
+		m := (from + i) % minutesPerWeek
+		b.words[m/64] |= 1 << (m % 64)
+	}
+}
+
+// Contains is true when the minute of wc is set
+func (b *WeekBitmap) Contains(wc WeekClock) bool {
+	return b.words[wc.min/64]&(1<<(wc.min%64)) != 0
+}
+
+// ContainsTime checks the weekday and clock of t in its own location
+func (b *WeekBitmap) ContainsTime(t time.Time) bool {
+	return b.Contains(WeekClockFromTime(t))
+}
+
+// And keeps only the minutes set in both
+func (b WeekBitmap) And(b2 WeekBitmap) WeekBitmap {
+	for i := range b.words {
+		b.words[i] &= b2.words[i]
+	}
+	return b
+}
+
+// Or keeps the minutes set in either
+func (b WeekBitmap) Or(b2 WeekBitmap) WeekBitmap {
+	for i := range b.words {
+		b.words[i] |= b2.words[i]
+	}
+	return b
+}
+
+// Not flips every minute of the week
+func (b WeekBitmap) Not() WeekBitmap {
+	for i := range b.words {
+		b.words[i] = ^b.words[i]
+	}
+	b.words[weekBitmapWords-1] &= lastWordMask
+	return b
+}
+
+// Overlaps is true when any minute is set in both
+func (b *WeekBitmap) Overlaps(b2 *WeekBitmap) bool {
+	for i := range b.words {
+		if b.words[i]&b2.words[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *WeekBitmap) Equal(b2 *WeekBitmap) bool { return b.words == b2.words }
+func (b *WeekBitmap) IsEmpty() bool             { return b.words == [weekBitmapWords]uint64{} }
+
+// Minutes is the number of minutes set
+func (b *WeekBitmap) Minutes() int {
+	var n int
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// ToWeekdayTimeSlots converts back into sorted slots which never overlap
+// time which continues past midnight is split into a slot for each day
+// and a day which is fully set is an all day slot
+func (b *WeekBitmap) ToWeekdayTimeSlots() []WeekdayTimeSlot {
+	var slots = make([]WeekdayTimeSlot, 0)
+	for day := 0; day < 7; day++ {
+		var (
+			dayStart = day * minutesPerDay
+			dayEnd   = dayStart + minutesPerDay
+		)
+		for m := dayStart; m < dayEnd; {
+			if !b.Contains(WeekClock{m}) {
+				m = b.nextSet(m, dayEnd)
+				continue
+			}
+			start := m
+			for m < dayEnd && b.Contains(WeekClock{m}) {
+				m++
+			}
+			slots = append(slots, NewWeekdayTimeSlotBetween(WeekClock{start}, WeekClockFromMinutes(m)))
+		}
+	}
+	return slots
+}
+
+// nextSet is the first set minute at or after m, or limit when there is none
+// entire empty words are skipped
+func (b *WeekBitmap) nextSet(m, limit int) int {
+	for m < limit {
+		w := b.words[m/64] >> (m % 64)
+		if w != 0 {
+			m += bits.TrailingZeros64(w)
+			break
+		}
+		m += 64 - m%64
+	}
+	if m > limit {
+		return limit
+	}
+	return m
+}
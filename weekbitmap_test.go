@@ -0,0 +1,136 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tempcke/schedule"
+)
+
+func TestWeekBitmap(t *testing.T) {
+	var (
+		mon0910 = schedule.WeekdayTimeSlotFromString("Monday 09:00-10:00")
+		mon0930 = schedule.WeekdayTimeSlotFromString("Monday 09:30-11:00")
+		tue2301 = schedule.WeekdayTimeSlotFromString("Tuesday 23:00-01:00")
+		sat2301 = schedule.WeekdayTimeSlotFromString("Saturday 23:00-01:00")
+		friDay  = schedule.WeekdayTimeSlotFromString("Friday")
+	)
+
+	t.Run("Contains", func(t *testing.T) {
+		b := schedule.CompileWeekdayTimeSlots(mon0910, tue2301, sat2301)
+		for _, slot := range []schedule.WeekdayTimeSlot{mon0910, tue2301, sat2301} {
+			for wc := slot.StartWeekClock(); !wc.Equal(slot.EndWeekClock()); wc = wc.Add(time.Minute) {
+				assert.True(t, b.Contains(wc), wc.String())
+			}
+			assert.False(t, b.Contains(slot.EndWeekClock()), slot.String())
+			assert.False(t, b.Contains(slot.StartWeekClock().Add(-time.Minute)), slot.String())
+		}
+		assert.Equal(t, 60+120+120, b.Minutes())
+
+		sunday0030 := time.Date(2022, 7, 10, 0, 30, 0, 0, time.UTC)
+		assert.True(t, b.ContainsTime(sunday0030))
+		assert.False(t, b.ContainsTime(sunday0030.Add(time.Hour)))
+	})
+
+	t.Run("And Or Not", func(t *testing.T) {
+		var (
+			a = schedule.CompileWeekdayTimeSlots(mon0910)
+			b = schedule.CompileWeekdayTimeSlots(mon0930)
+		)
+		and := a.And(b)
+		assert.Equal(t, []schedule.WeekdayTimeSlot{
+			schedule.WeekdayTimeSlotFromString("Monday 09:30-10:00"),
+		}, and.ToWeekdayTimeSlots())
+
+		or := a.Or(b)
+		assert.Equal(t, []schedule.WeekdayTimeSlot{
+			schedule.WeekdayTimeSlotFromString("Monday 09:00-11:00"),
+		}, or.ToWeekdayTimeSlots())
+
+		not := a.Not()
+		assert.Equal(t, 7*24*60-60, not.Minutes())
+		assert.False(t, not.Overlaps(&a))
+		assert.True(t, a.Overlaps(&b))
+
+		all := not.Or(a)
+		assert.Len(t, all.ToWeekdayTimeSlots(), 7)
+		none := all.Not()
+		assert.True(t, none.IsEmpty())
+	})
+
+	t.Run("ToWeekdayTimeSlots normalises", func(t *testing.T) {
+		b := schedule.CompileWeekdayTimeSlots(sat2301, friDay, mon0930, mon0910, tue2301, mon0910)
+		expect := []schedule.WeekdayTimeSlot{
+			schedule.WeekdayTimeSlotFromString("Sunday 00:00-01:00"),
+			schedule.WeekdayTimeSlotFromString("Monday 09:00-11:00"),
+			schedule.WeekdayTimeSlotFromString("Tuesday 23:00-00:00"),
+			schedule.WeekdayTimeSlotFromString("Wednesday 00:00-01:00"),
+			schedule.WeekdayTimeSlotFromString("Friday"),
+			schedule.WeekdayTimeSlotFromString("Saturday 23:00-00:00"),
+		}
+		assert.Equal(t, expect, b.ToWeekdayTimeSlots())
+
+		roundTrip := schedule.CompileWeekdayTimeSlots(expect...)
+		assert.True(t, b.Equal(&roundTrip))
+	})
+}
+
+var benchSlots = []schedule.WeekdayTimeSlot{
+	schedule.WeekdayTimeSlotFromString("Monday 08:00-12:00"),
+	schedule.WeekdayTimeSlotFromString("Monday 13:00-17:00"),
+	schedule.WeekdayTimeSlotFromString("Tuesday 08:00-12:00"),
+	schedule.WeekdayTimeSlotFromString("Tuesday 13:00-17:00"),
+	schedule.WeekdayTimeSlotFromString("Wednesday 08:00-12:00"),
+	schedule.WeekdayTimeSlotFromString("Wednesday 13:00-17:00"),
+	schedule.WeekdayTimeSlotFromString("Thursday 08:00-12:00"),
+	schedule.WeekdayTimeSlotFromString("Thursday 13:00-17:00"),
+	schedule.WeekdayTimeSlotFromString("Friday 08:00-12:00"),
+	schedule.WeekdayTimeSlotFromString("Friday 13:00-17:00"),
+	schedule.WeekdayTimeSlotFromString("Saturday 22:00-02:00"),
+}
+
+var benchResult bool
+
+func BenchmarkWeekBitmap_Contains(b *testing.B) {
+	bm := schedule.CompileWeekdayTimeSlots(benchSlots...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResult = bm.Contains(schedule.WeekClockFromMinutes(i))
+	}
+}
+
+func BenchmarkWeekdayTimeSlot_OverlapsWith(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var (
+			wc     = schedule.WeekClockFromMinutes(i)
+			minute = schedule.NewWeekdayTimeSlotBetween(wc, wc.Add(time.Minute))
+			found  = false
+		)
+		for _, slot := range benchSlots {
+			if slot.OverlapsWith(minute) {
+				found = true
+				break
+			}
+		}
+		benchResult = found
+	}
+}
+
+func BenchmarkWeekBitmap_And(b *testing.B) {
+	var (
+		x = schedule.CompileWeekdayTimeSlots(benchSlots...)
+		y = schedule.CompileWeekdayTimeSlots(benchSlots[2:6]...)
+	)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		and := x.And(y)
+		benchResult = and.IsEmpty()
+	}
+}
+
+func BenchmarkMergeWeekdayTimeSlots(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchResult = len(schedule.MergeWeekdayTimeSlots(benchSlots, benchSlots[2:6])) == 0
+	}
+}
@@ -0,0 +1,122 @@
+package schedule
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+)
+
+// WeekClock is a Weekday and Clock, a minute within the week
+// it is stored as minutes since Sunday 00:00 and, just like a Clock,
+// it wraps so adding past Saturday 23:59 continues from Sunday 00:00
+type WeekClock struct {
+	min int
+}
+
+func NewWeekClock(day Weekday, clock Clock) WeekClock {
+	return WeekClockFromMinutes(int(day)*minutesPerDay + clock.min)
+}
+
+// WeekClockFromMinutes takes minutes since Sunday 00:00
+// values outside of a week wrap around
+func WeekClockFromMinutes(minutes int) WeekClock {
+	m := minutes % minutesPerWeek
+	if m < 0 {
+		m += minutesPerWeek
+	}
+	return WeekClock{m}
+}
+
+// WeekClockFromTime takes the weekday and clock of t in its own location
+func WeekClockFromTime(t time.Time) WeekClock {
+	return NewWeekClock(Weekday(t.Weekday()), NewClock(t.Hour(), t.Minute()))
+}
+
+// ParseWeekClock takes a string such as "Monday 09:00"
+func ParseWeekClock(s string) WeekClock {
+	parts := strings.Fields(s)
+	if len(parts) != 2 {
+		return WeekClock{}
+	}
+	day, err := ParseWeekday(parts[0])
+	if err != nil {
+		return WeekClock{}
+	}
+	return NewWeekClock(day, ParseClock(parts[1]))
+}
+
+// String of a WeekClock "Monday 09:00"
+func (w WeekClock) String() string {
+	return w.Weekday().String() + " " + w.Clock().String()
+}
+
+func (w WeekClock) Weekday() Weekday         { return Weekday(w.min / minutesPerDay) }
+func (w WeekClock) Clock() Clock             { return Clock{w.min % minutesPerDay} }
+func (w WeekClock) Minutes() int             { return w.min }
+func (w WeekClock) Equal(w2 WeekClock) bool  { return w.min == w2.min }
+func (w WeekClock) Before(w2 WeekClock) bool { return w.min < w2.min }
+func (w WeekClock) After(w2 WeekClock) bool  { return w.min > w2.min }
+func (w WeekClock) IsZero() bool             { return w.min == 0 }
+
+// Add a duration to a WeekClock, anything less than a minute is dropped
+func (w WeekClock) Add(d time.Duration) WeekClock {
+	return WeekClockFromMinutes(w.min + int(d/time.Minute))
+}
+
+// Sub is how long it takes to go forward from w2 until w
+// so it is never negative and always less than a week
+//
+//	Monday 09:00 sub Monday 08:00 = 1h
+//	Monday 08:00 sub Monday 09:00 = 167h
+func (w WeekClock) Sub(w2 WeekClock) time.Duration {
+	return time.Duration(WeekClockFromMinutes(w.min-w2.min).min) * time.Minute
+}
+
+// MarshalJSON marshals as a quoted json string
+func (w WeekClock) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(w.String())
+	buffer.WriteString(`"`)
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON unmashals a quoted json string
+func (w *WeekClock) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*w = ParseWeekClock(s)
+	return nil
+}
+
+// Value implements driver.Valuer which is used parsing sql param values
+func (w WeekClock) Value() (driver.Value, error) {
+	return w.String(), nil
+}
+
+// Scan implements sql.Scanner, ints are minutes since Sunday 00:00
+func (w *WeekClock) Scan(src interface{}) error {
+	switch t := src.(type) {
+	case int:
+		*w = WeekClockFromMinutes(t)
+	case int64:
+		*w = WeekClockFromMinutes(int(t))
+	case string:
+		*w = ParseWeekClock(t)
+	case []byte:
+		*w = ParseWeekClock(string(t))
+	default:
+		return errors.New("WeekClock.Scan requires an int, string or byte array")
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package schedule_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestWeekClock(t *testing.T) {
+	var (
+		mon0900 = schedule.NewWeekClock(schedule.Monday, schedule.NewClock(9, 0))
+		sat2330 = schedule.NewWeekClock(schedule.Saturday, schedule.NewClock(23, 30))
+	)
+
+	t.Run("interface impl check", func(t *testing.T) {
+		var (
+			_ json.Marshaler   = (*schedule.WeekClock)(nil)
+			_ json.Unmarshaler = (*schedule.WeekClock)(nil)
+			_ sql.Scanner      = (*schedule.WeekClock)(nil)
+			_ driver.Valuer    = (*schedule.WeekClock)(nil)
+		)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		assert.Equal(t, schedule.Monday, mon0900.Weekday())
+		assert.Equal(t, schedule.NewClock(9, 0), mon0900.Clock())
+		assert.Equal(t, 24*60+9*60, mon0900.Minutes())
+		assert.Equal(t, "Monday 09:00", mon0900.String())
+		assert.Equal(t, mon0900, schedule.ParseWeekClock("Monday 09:00"))
+		assert.Equal(t, mon0900, schedule.ParseWeekClock("monday 09:00"))
+		assert.True(t, schedule.ParseWeekClock("Funday 09:00").IsZero())
+		assert.True(t, schedule.WeekClock{}.IsZero())
+	})
+
+	t.Run("from time", func(t *testing.T) {
+		tm := time.Date(2022, 7, 11, 9, 0, 59, 0, time.UTC) // Monday
+		assert.Equal(t, mon0900, schedule.WeekClockFromTime(tm))
+	})
+
+	t.Run("Add wraps across the week", func(t *testing.T) {
+		assert.Equal(t, "Sunday 00:30", sat2330.Add(time.Hour).String())
+		assert.Equal(t, "Saturday 23:30", mon0900.Add(-(33*time.Hour + 30*time.Minute)).String())
+		assert.Equal(t, mon0900, mon0900.Add(7*24*time.Hour))
+		assert.Equal(t, mon0900, mon0900.Add(59*time.Second))
+	})
+
+	t.Run("Sub", func(t *testing.T) {
+		mon0800 := schedule.ParseWeekClock("Monday 08:00")
+		assert.Equal(t, time.Hour, mon0900.Sub(mon0800))
+		assert.Equal(t, 167*time.Hour, mon0800.Sub(mon0900))
+		assert.Equal(t, time.Duration(0), mon0900.Sub(mon0900))
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		assert.True(t, mon0900.Before(sat2330))
+		assert.True(t, sat2330.After(mon0900))
+		assert.True(t, mon0900.Equal(schedule.ParseWeekClock("Monday 09:00")))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(mon0900)
+		require.NoError(t, err)
+		assert.Equal(t, `"Monday 09:00"`, string(b))
+
+		var wc schedule.WeekClock
+		require.NoError(t, json.Unmarshal(b, &wc))
+		assert.Equal(t, mon0900, wc)
+	})
+
+	scanTests := map[string]interface{}{
+		"int":    24*60 + 9*60,
+		"int64":  int64(24*60 + 9*60),
+		"string": "Monday 09:00",
+		"bytes":  []byte("Monday 09:00"),
+	}
+	for name, src := range scanTests {
+		t.Run("scan "+name, func(t *testing.T) {
+			var wc schedule.WeekClock
+			require.NoError(t, wc.Scan(src))
+			assert.Equal(t, mon0900, wc)
+
+			v, err := wc.Value()
+			require.NoError(t, err)
+			assert.Equal(t, "Monday 09:00", v)
+		})
+	}
+}
+
+func TestWeekdayTimeSlot_WeekClock(t *testing.T) {
+	var tests = map[string]struct {
+		start, end string
+	}{
+		"Monday 09:00-10:00":   {"Monday 09:00", "Monday 10:00"},
+		"Monday 23:00-01:00":   {"Monday 23:00", "Tuesday 01:00"},
+		"Saturday 23:00-01:00": {"Saturday 23:00", "Sunday 01:00"},
+		"Saturday":             {"Saturday 00:00", "Sunday 00:00"},
+	}
+	for input, tc := range tests {
+		t.Run(input, func(t *testing.T) {
+			var (
+				wts   = schedule.WeekdayTimeSlotFromString(input)
+				start = schedule.ParseWeekClock(tc.start)
+				end   = schedule.ParseWeekClock(tc.end)
+			)
+			assert.Equal(t, start, wts.StartWeekClock())
+			assert.Equal(t, end, wts.EndWeekClock())
+			assert.Equal(t, wts, schedule.NewWeekdayTimeSlotBetween(start, end))
+
+			assert.True(t, wts.Contains(start))
+			assert.True(t, wts.Contains(end.Add(-time.Minute)))
+			assert.False(t, wts.Contains(end))
+			assert.False(t, wts.Contains(start.Add(-time.Minute)))
+		})
+	}
+}
@@ -63,12 +63,26 @@ func ParseWeekday(dayName string) (Weekday, error) {
 	return 0, ErrInvalidDayName
 }
 
+// TodayWeekday is the current weekday in the time zone of the process
 func TodayWeekday() Weekday {
 	return Today().Weekday()
 }
 
+// TodayWeekdayIn is the current weekday in loc
+func TodayWeekdayIn(loc *time.Location) Weekday {
+	return TodayIn(loc).Weekday()
+}
+
+// TodayWeekdayFrom is the current weekday of p, see TodayFrom
+func TodayWeekdayFrom(p NowProvider) Weekday {
+	return TodayFrom(p).Weekday()
+}
+
 func (w Weekday) String() string { return time.Weekday(w).String() }
 
+// IsValid is false for any value outside of Sunday through Saturday
+func (w Weekday) IsValid() bool { return w >= Sunday && w <= Saturday }
+
 func (w Weekday) Next() Weekday {
 	if w == Saturday {
 		return Sunday
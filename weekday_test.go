@@ -97,3 +97,10 @@ func TestWeekday_jsonEncode(t *testing.T) {
 		assert.True(t, days.D == nil)
 	})
 }
+
+func TestWeekday_IsValid(t *testing.T) {
+	assert.True(t, schedule.Sunday.IsValid())
+	assert.True(t, schedule.Saturday.IsValid())
+	assert.False(t, schedule.Weekday(7).IsValid())
+	assert.False(t, schedule.Weekday(-1).IsValid())
+}
@@ -0,0 +1,86 @@
+package schedule
+
+import (
+	"encoding/json"
+	"math/bits"
+	"strings"
+)
+
+var (
+	_ json.Marshaler   = (*WeekdaySet)(nil)
+	_ json.Unmarshaler = (*WeekdaySet)(nil)
+)
+
+// WeekdaySet is a set of weekdays with one bit for each, starting at Sunday
+type WeekdaySet uint8
+
+// Weekend is Saturday and Sunday
+const Weekend = WeekdaySet(1<<Saturday | 1<<Sunday)
+
+// NewWeekdaySet ignores weekdays which are not valid
+func NewWeekdaySet(days ...Weekday) WeekdaySet {
+	var s WeekdaySet
+	return s.With(days...)
+}
+
+func (s WeekdaySet) With(days ...Weekday) WeekdaySet {
+	for _, w := range days {
+		if w.IsValid() {
+			s |= 1 << w
+		}
+	}
+	return s
+}
+
+func (s WeekdaySet) Without(days ...Weekday) WeekdaySet {
+	for _, w := range days {
+		if w.IsValid() {
+			s &^= 1 << w
+		}
+	}
+	return s
+}
+
+func (s WeekdaySet) Contains(w Weekday) bool { return w.IsValid() && s&(1<<w) != 0 }
+func (s WeekdaySet) Len() int                { return bits.OnesCount8(uint8(s & allWeekdays)) }
+func (s WeekdaySet) IsEmpty() bool           { return s&allWeekdays == 0 }
+
+// allWeekdays is every day of the week
+const allWeekdays = WeekdaySet(1<<7 - 1)
+
+// Complement is every weekday not in s, the complement of Weekend is Monday to Friday
+func (s WeekdaySet) Complement() WeekdaySet { return ^s & allWeekdays }
+
+// Weekdays lists the days in order starting at Sunday
+func (s WeekdaySet) Weekdays() []Weekday {
+	var days = make([]Weekday, 0, s.Len())
+	for w := Sunday; w <= Saturday; w++ {
+		if s.Contains(w) {
+			days = append(days, w)
+		}
+	}
+	return days
+}
+
+func (s WeekdaySet) String() string {
+	var names = make([]string, 0, s.Len())
+	for _, w := range s.Weekdays() {
+		names = append(names, w.String())
+	}
+	return strings.Join(names, ",")
+}
+
+// MarshalJSON is a list of weekday names, ["Sunday","Saturday"]
+func (s WeekdaySet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Weekdays())
+}
+
+// UnmarshalJSON takes a list of weekday names or numbers
+func (s *WeekdaySet) UnmarshalJSON(b []byte) error {
+	var days []Weekday
+	if err := json.Unmarshal(b, &days); err != nil {
+		return err
+	}
+	*s = NewWeekdaySet(days...)
+	return nil
+}
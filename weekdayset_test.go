@@ -0,0 +1,34 @@
+package schedule_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestWeekdaySet(t *testing.T) {
+	var weekend = schedule.Weekend
+	assert.Equal(t, schedule.NewWeekdaySet(schedule.Saturday, schedule.Sunday), weekend)
+	assert.Equal(t, 2, weekend.Len())
+	assert.True(t, weekend.Contains(schedule.Sunday))
+	assert.False(t, weekend.Contains(schedule.Monday))
+	assert.False(t, weekend.Contains(schedule.Weekday(8)))
+	assert.Equal(t, "Sunday,Saturday", weekend.String())
+	assert.Equal(t, "Monday,Tuesday,Wednesday,Thursday,Friday", weekend.Complement().String())
+	assert.Equal(t, "Sunday", weekend.Without(schedule.Saturday).String())
+	assert.Equal(t, weekend, schedule.NewWeekdaySet(schedule.Sunday).With(schedule.Saturday, schedule.Weekday(9)))
+	assert.True(t, schedule.NewWeekdaySet().IsEmpty())
+	assert.True(t, weekend.Complement().Complement() == weekend)
+
+	b, err := json.Marshal(weekend)
+	require.NoError(t, err)
+	assert.Equal(t, `["Sunday","Saturday"]`, string(b))
+
+	var s schedule.WeekdaySet
+	require.NoError(t, json.Unmarshal([]byte(`["Friday",6]`), &s))
+	assert.Equal(t, schedule.NewWeekdaySet(schedule.Friday, schedule.Saturday), s)
+	assert.Error(t, json.Unmarshal([]byte(`["Someday"]`), &s))
+}
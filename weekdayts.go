@@ -31,9 +31,9 @@ func (s *WeekdayTimeSlot) Scan(src interface{}) error {
 	}
 	switch t := src.(type) {
 	case int:
-		*s = WeekdayTimeSlotFromInt(t)
+		return s.scanKey(int64(t))
 	case int64:
-		*s = WeekdayTimeSlotFromInt(int(t))
+		return s.scanKey(t)
 	case string:
 		*s = WeekdayTimeSlotFromString(t)
 	case []byte:
@@ -43,6 +43,16 @@ func (s *WeekdayTimeSlot) Scan(src interface{}) error {
 	}
 	return nil
 }
+
+// scanKey accepts keys from either ToInt or ToKey
+func (s *WeekdayTimeSlot) scanKey(key int64) error {
+	wts, err := WeekdayTimeSlotFromKey(key)
+	if err != nil {
+		return err
+	}
+	*s = wts
+	return nil
+}
 func (s WeekdayTimeSlot) Value() (driver.Value, error) {
 	return s.String(), nil
 }
@@ -0,0 +1,155 @@
+package schedule
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const ymFormat = "2006-01"
+
+var _ json.Marshaler = (*YearMonth)(nil)
+var _ json.Unmarshaler = (*YearMonth)(nil)
+var _ encoding.TextMarshaler = (*YearMonth)(nil)
+var _ encoding.TextUnmarshaler = (*YearMonth)(nil)
+var _ sql.Scanner = (*YearMonth)(nil)
+var _ driver.Valuer = (*YearMonth)(nil)
+
+// YearMonth is a month of a specific year such as "2026-10"
+type YearMonth struct {
+	year  int
+	month time.Month
+}
+
+// NewYearMonth normalizes the month so NewYearMonth(2026, 13) is 2027-01
+func NewYearMonth(year int, month time.Month) YearMonth {
+	m := int(month) - 1
+	year += m / 12
+	if m %= 12; m < 0 {
+		m += 12
+		year--
+	}
+	return YearMonth{year: year, month: time.Month(m + 1)}
+}
+
+// ParseYearMonth takes the "2006-01" format, a full date such as
+// "2026-10-17" is also accepted and the day is ignored
+func ParseYearMonth(s string) (YearMonth, error) {
+	if len(s) > len(ymFormat) && s[len(ymFormat)] == '-' {
+		s = s[0:len(ymFormat)]
+	}
+	t, err := time.Parse(ymFormat, s)
+	if err != nil {
+		return YearMonth{}, fmt.Errorf("%w: %s", ErrInvalidYearMonthString, s)
+	}
+	return NewYearMonth(t.Year(), t.Month()), nil
+}
+
+func (d Date) YearMonth() YearMonth { return NewYearMonth(d.Year(), d.Month()) }
+
+func (ym YearMonth) String() string {
+	if ym.year < 0 || ym.year > 9999 {
+		return ym.Start().ToTime().Format(ymFormat)
+	}
+	return fmt.Sprintf("%04d-%02d", ym.year, int(ym.month))
+}
+
+func (ym YearMonth) Year() int                 { return ym.year }
+func (ym YearMonth) Month() time.Month         { return ym.month }
+func (ym YearMonth) Before(ym2 YearMonth) bool { return ym.months() < ym2.months() }
+func (ym YearMonth) After(ym2 YearMonth) bool  { return ym.months() > ym2.months() }
+func (ym YearMonth) Equal(ym2 YearMonth) bool  { return ym == ym2 }
+func (ym YearMonth) Next() YearMonth           { return ym.AddMonths(1) }
+func (ym YearMonth) Prev() YearMonth           { return ym.AddMonths(-1) }
+func (ym YearMonth) Pointer() *YearMonth       { return &ym }
+func (ym *YearMonth) IsZero() bool             { return ym == nil || *ym == YearMonth{} }
+func (ym YearMonth) AddMonths(n int) YearMonth { return NewYearMonth(ym.year, ym.month+time.Month(n)) }
+func (ym YearMonth) AddYears(n int) YearMonth  { return NewYearMonth(ym.year+n, ym.month) }
+func (ym YearMonth) Start() Date               { return NewDate(ym.year, ym.month, 1) }
+func (ym YearMonth) End() Date                 { return NewDate(ym.year, ym.month+1, 0) }
+func (ym YearMonth) DayCount() int             { return daysIn(ym.month, ym.year) }
+func (ym YearMonth) ContainsDate(d Date) bool  { return d.YearMonth() == ym }
+func (ym YearMonth) DateRange() DateRange      { return NewDateRangeUntil(ym.Start(), ym.End().Pointer()) }
+func (ym YearMonth) months() int               { return ym.year*12 + int(ym.month) - 1 }
+
+// Sub is the number of months between
+//
+//	2026-10 sub 2026-01 = 9
+//	2026-01 sub 2026-10 = -9
+func (ym YearMonth) Sub(ym2 YearMonth) int {
+	return ym.months() - ym2.months()
+}
+
+// Days lists every date in the month
+func (ym YearMonth) Days() []Date {
+	var (
+		days = make([]Date, ym.DayCount())
+		d    = ym.Start()
+	)
+	for i := range days {
+		days[i] = d
+		d = d.Next()
+	}
+	return days
+}
+
+func (ym YearMonth) MarshalText() (text []byte, err error) {
+	return []byte(ym.String()), nil
+}
+
+func (ym *YearMonth) UnmarshalText(text []byte) error {
+	v, err := ParseYearMonth(string(text))
+	if err != nil {
+		return err
+	}
+	*ym = v
+	return nil
+}
+
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(ym.String())
+	buffer.WriteString(`"`)
+	return buffer.Bytes(), nil
+}
+
+func (ym *YearMonth) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	return ym.UnmarshalText([]byte(s))
+}
+
+func (ym *YearMonth) Scan(src interface{}) error {
+	if src == nil {
+		return nil
+	}
+	switch t := src.(type) {
+	case time.Time:
+		*ym = NewYearMonth(t.Year(), t.Month())
+	case string:
+		return ym.UnmarshalText([]byte(t))
+	case []byte:
+		return ym.UnmarshalText(t)
+	default:
+		return fmt.Errorf("YearMonth.Scan requires a string or byte array in yyyy-mm format got %T %v", src, src)
+	}
+	return nil
+}
+
+func (ym YearMonth) Value() (driver.Value, error) {
+	if ym.IsZero() {
+		return nil, nil
+	}
+	return ym.String(), nil
+}
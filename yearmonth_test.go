@@ -0,0 +1,120 @@
+package schedule_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestYearMonth(t *testing.T) {
+	oct := schedule.NewYearMonth(2026, time.October)
+
+	t.Run("interface impl check", func(t *testing.T) {
+		var (
+			v = (*schedule.YearMonth)(nil)
+
+			_ json.Marshaler           = v
+			_ json.Unmarshaler         = v
+			_ encoding.TextMarshaler   = v
+			_ encoding.TextUnmarshaler = v
+			_ sql.Scanner              = v
+			_ driver.Valuer            = v
+		)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		assert.Equal(t, 2026, oct.Year())
+		assert.Equal(t, time.October, oct.Month())
+		assert.Equal(t, "2026-10", oct.String())
+		assert.Equal(t, oct, schedule.NewDate(2026, 10, 17).YearMonth())
+		assert.Equal(t, "2027-01", schedule.NewYearMonth(2026, 13).String())
+		assert.Equal(t, "2025-12", schedule.NewYearMonth(2026, 0).String())
+		assert.True(t, schedule.YearMonth{}.Pointer().IsZero())
+		assert.False(t, oct.Pointer().IsZero())
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		for _, s := range []string{"2026-10", "2026-10-17"} {
+			ym, err := schedule.ParseYearMonth(s)
+			require.NoError(t, err, s)
+			assert.Equal(t, oct, ym, s)
+		}
+		for _, s := range []string{"", "2026", "2026-13", "202610", "2026-10x"} {
+			_, err := schedule.ParseYearMonth(s)
+			assert.ErrorIs(t, err, schedule.ErrInvalidYearMonthString, s)
+		}
+	})
+
+	t.Run("arithmetic and comparison", func(t *testing.T) {
+		assert.Equal(t, "2026-11", oct.Next().String())
+		assert.Equal(t, "2026-09", oct.Prev().String())
+		assert.Equal(t, "2027-02", oct.AddMonths(4).String())
+		assert.Equal(t, "2025-10", oct.AddYears(-1).String())
+		assert.Equal(t, 9, oct.Sub(schedule.NewYearMonth(2026, 1)))
+		assert.Equal(t, -13, oct.Sub(schedule.NewYearMonth(2027, 11)))
+		assert.True(t, oct.Before(oct.Next()))
+		assert.True(t, oct.After(oct.Prev()))
+		assert.True(t, oct.Equal(schedule.NewYearMonth(2026, 10)))
+	})
+
+	t.Run("dates", func(t *testing.T) {
+		feb := schedule.NewYearMonth(2024, time.February)
+		assert.Equal(t, schedule.NewDate(2024, 2, 1), feb.Start())
+		assert.Equal(t, schedule.NewDate(2024, 2, 29), feb.End())
+		assert.Equal(t, 29, feb.DayCount())
+		assert.True(t, feb.ContainsDate(schedule.NewDate(2024, 2, 29)))
+		assert.False(t, feb.ContainsDate(schedule.NewDate(2024, 3, 1)))
+
+		dr := feb.DateRange()
+		assert.Equal(t, "from 2024-02-01 until 2024-02-29", dr.String())
+		assert.Equal(t, 29, dr.DayCount())
+
+		days := feb.Days()
+		require.Len(t, days, 29)
+		assert.Equal(t, feb.Start(), days[0])
+		assert.Equal(t, feb.End(), days[28])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		type Months map[schedule.YearMonth]schedule.YearMonth
+		in := Months{oct: oct.Next()}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.Equal(t, `{"2026-10":"2026-11"}`, string(b))
+
+		var out Months
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+
+		var v struct{ A, B schedule.YearMonth }
+		require.NoError(t, json.Unmarshal([]byte(`{"A":"","B":null}`), &v))
+		assert.True(t, v.A.IsZero())
+		assert.True(t, v.B.IsZero())
+		assert.Error(t, json.Unmarshal([]byte(`{"A":"nope"}`), &v))
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		v, err := oct.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "2026-10", v)
+
+		for _, src := range []interface{}{"2026-10", []byte("2026-10-01"), time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)} {
+			var ym schedule.YearMonth
+			require.NoError(t, ym.Scan(src))
+			assert.Equal(t, oct, ym)
+		}
+
+		var ym *schedule.YearMonth
+		require.NoError(t, ym.Scan(nil))
+		v, err = schedule.YearMonth{}.Value()
+		require.NoError(t, err)
+		assert.Nil(t, v)
+	})
+}
@@ -0,0 +1,164 @@
+package schedule
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var _ json.Marshaler = (*YearWeek)(nil)
+var _ json.Unmarshaler = (*YearWeek)(nil)
+var _ encoding.TextMarshaler = (*YearWeek)(nil)
+var _ encoding.TextUnmarshaler = (*YearWeek)(nil)
+var _ sql.Scanner = (*YearWeek)(nil)
+var _ driver.Valuer = (*YearWeek)(nil)
+
+// YearWeek is an ISO 8601 week such as "2026-W42"
+// weeks start on Monday and week 1 is the week with the year's first Thursday,
+// so the first days of January can be in the last week of the previous year
+// and the last days of December can be in week 1 of the next year
+type YearWeek struct {
+	year int
+	week int
+}
+
+// NewYearWeek normalizes the week so week 53 of a year with only 52 weeks
+// is week 1 of the next year
+func NewYearWeek(year, week int) YearWeek {
+	return isoWeekOneStart(year).AddDate(0, 0, (week-1)*7).ISOWeek()
+}
+
+// ParseYearWeek takes the "2026-W42" format, "2026W42" is also accepted
+func ParseYearWeek(s string) (YearWeek, error) {
+	parts := strings.SplitN(strings.Replace(s, "-W", "W", 1), "W", 2)
+	if len(parts) != 2 || len(parts[0]) != 4 || len(parts[1]) != 2 {
+		return YearWeek{}, fmt.Errorf("%w: %s", ErrInvalidYearWeekString, s)
+	}
+	year, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return YearWeek{}, fmt.Errorf("%w: %s", ErrInvalidYearWeekString, s)
+	}
+	week, err := strconv.Atoi(parts[1])
+	if err != nil || week < 1 || week > ISOWeeksInYear(year) {
+		return YearWeek{}, fmt.Errorf("%w: %s", ErrInvalidYearWeekString, s)
+	}
+	return YearWeek{year: year, week: week}, nil
+}
+
+// ISOWeek is the ISO 8601 week which contains the date
+func (d Date) ISOWeek() YearWeek {
+	var (
+		thursday = d.StartOfWeek(Monday).AddDate(0, 0, 3)
+		year     = thursday.Year()
+	)
+	return YearWeek{
+		year: year,
+		week: thursday.Sub(NewDate(year, time.January, 1))/7 + 1,
+	}
+}
+
+// ISOWeeksInYear is 52 or 53
+func ISOWeeksInYear(year int) int {
+	return NewDate(year, time.December, 28).ISOWeek().week
+}
+
+// isoWeekOneStart is the Monday of the week with January 4th
+func isoWeekOneStart(year int) Date {
+	return NewDate(year, time.January, 4).StartOfWeek(Monday)
+}
+
+func (yw YearWeek) String() string {
+	return fmt.Sprintf("%04d-W%02d", yw.year, yw.week)
+}
+
+func (yw YearWeek) Year() int                { return yw.year }
+func (yw YearWeek) Week() int                { return yw.week }
+func (yw YearWeek) Before(yw2 YearWeek) bool { return yw.Start().Before(yw2.Start()) }
+func (yw YearWeek) After(yw2 YearWeek) bool  { return yw.Start().After(yw2.Start()) }
+func (yw YearWeek) Equal(yw2 YearWeek) bool  { return yw == yw2 }
+func (yw YearWeek) Next() YearWeek           { return yw.AddWeeks(1) }
+func (yw YearWeek) Prev() YearWeek           { return yw.AddWeeks(-1) }
+func (yw YearWeek) AddWeeks(n int) YearWeek  { return yw.Start().AddDate(0, 0, n*7).ISOWeek() }
+func (yw YearWeek) Pointer() *YearWeek       { return &yw }
+func (yw *YearWeek) IsZero() bool            { return yw == nil || *yw == YearWeek{} }
+func (yw YearWeek) Start() Date              { return isoWeekOneStart(yw.year).AddDate(0, 0, (yw.week-1)*7) }
+func (yw YearWeek) End() Date                { return yw.Start().AddDate(0, 0, 6) }
+func (yw YearWeek) ContainsDate(d Date) bool { return d.ISOWeek() == yw }
+func (yw YearWeek) DateRange() DateRange     { return NewDateRangeUntil(yw.Start(), yw.End().Pointer()) }
+func (yw YearWeek) Sub(yw2 YearWeek) int     { return yw.Start().Sub(yw2.Start()) / 7 }
+
+// Days lists the seven dates of the week, Monday through Sunday
+func (yw YearWeek) Days() []Date {
+	var (
+		days = make([]Date, 7)
+		d    = yw.Start()
+	)
+	for i := range days {
+		days[i] = d
+		d = d.Next()
+	}
+	return days
+}
+
+func (yw YearWeek) MarshalText() (text []byte, err error) {
+	return []byte(yw.String()), nil
+}
+
+func (yw *YearWeek) UnmarshalText(text []byte) error {
+	v, err := ParseYearWeek(string(text))
+	if err != nil {
+		return err
+	}
+	*yw = v
+	return nil
+}
+
+func (yw YearWeek) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(yw.String())
+	buffer.WriteString(`"`)
+	return buffer.Bytes(), nil
+}
+
+func (yw *YearWeek) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	return yw.UnmarshalText([]byte(s))
+}
+
+func (yw *YearWeek) Scan(src interface{}) error {
+	if src == nil {
+		return nil
+	}
+	switch t := src.(type) {
+	case time.Time:
+		*yw = NewDateFromTime(t).ISOWeek()
+	case string:
+		return yw.UnmarshalText([]byte(t))
+	case []byte:
+		return yw.UnmarshalText(t)
+	default:
+		return fmt.Errorf("YearWeek.Scan requires a string or byte array in yyyy-Www format got %T %v", src, src)
+	}
+	return nil
+}
+
+func (yw YearWeek) Value() (driver.Value, error) {
+	if yw.IsZero() {
+		return nil, nil
+	}
+	return yw.String(), nil
+}
@@ -0,0 +1,116 @@
+package schedule_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/schedule"
+)
+
+func TestYearWeek(t *testing.T) {
+	w42 := schedule.NewYearWeek(2026, 42)
+
+	t.Run("interface impl check", func(t *testing.T) {
+		var (
+			v = (*schedule.YearWeek)(nil)
+
+			_ json.Marshaler           = v
+			_ json.Unmarshaler         = v
+			_ encoding.TextMarshaler   = v
+			_ encoding.TextUnmarshaler = v
+			_ sql.Scanner              = v
+			_ driver.Valuer            = v
+		)
+	})
+
+	t.Run("matches time.ISOWeek", func(t *testing.T) {
+		start := time.Date(1999, 12, 1, 0, 0, 0, 0, time.UTC)
+		for tm := start; tm.Year() < 2031; tm = tm.AddDate(0, 0, 1) {
+			year, week := tm.ISOWeek()
+			yw := schedule.NewDateFromTime(tm).ISOWeek()
+			if yw.Year() != year || yw.Week() != week {
+				t.Fatalf("%v expected %d-W%d got %v", tm, year, week, yw)
+			}
+			if !yw.ContainsDate(schedule.NewDateFromTime(tm)) {
+				t.Fatalf("%v should contain %v", yw, tm)
+			}
+		}
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		assert.Equal(t, 2026, w42.Year())
+		assert.Equal(t, 42, w42.Week())
+		assert.Equal(t, "2026-W42", w42.String())
+		assert.Equal(t, schedule.NewDate(2026, 10, 12), w42.Start())
+		assert.Equal(t, schedule.NewDate(2026, 10, 18), w42.End())
+		assert.Equal(t, "from 2026-10-12 until 2026-10-18", w42.DateRange().String())
+
+		days := w42.Days()
+		require.Len(t, days, 7)
+		assert.Equal(t, schedule.Monday, days[0].Weekday())
+		assert.Equal(t, schedule.Sunday, days[6].Weekday())
+	})
+
+	t.Run("week year differs from calendar year", func(t *testing.T) {
+		assert.Equal(t, "2020-W53", schedule.NewDate(2021, 1, 3).ISOWeek().String())
+		assert.Equal(t, "2025-W01", schedule.NewDate(2024, 12, 30).ISOWeek().String())
+		assert.Equal(t, 53, schedule.ISOWeeksInYear(2020))
+		assert.Equal(t, 52, schedule.ISOWeeksInYear(2021))
+		assert.Equal(t, 53, schedule.ISOWeeksInYear(2026))
+		assert.Equal(t, "2022-W01", schedule.NewYearWeek(2021, 53).String())
+		assert.Equal(t, "2020-W53", schedule.NewYearWeek(2021, 0).String())
+	})
+
+	t.Run("arithmetic and comparison", func(t *testing.T) {
+		assert.Equal(t, "2026-W43", w42.Next().String())
+		assert.Equal(t, "2026-W41", w42.Prev().String())
+		assert.Equal(t, "2027-W01", w42.AddWeeks(12).String())
+		assert.Equal(t, 12, w42.AddWeeks(12).Sub(w42))
+		assert.Equal(t, -42, schedule.NewYearWeek(2026, 0).Sub(w42))
+		assert.True(t, w42.Before(w42.Next()))
+		assert.True(t, w42.After(w42.Prev()))
+		assert.True(t, w42.Equal(schedule.NewYearWeek(2026, 42)))
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		for _, s := range []string{"2026-W42", "2026W42"} {
+			yw, err := schedule.ParseYearWeek(s)
+			require.NoError(t, err, s)
+			assert.Equal(t, w42, yw, s)
+		}
+		for _, s := range []string{"", "2026-42", "2026-W4", "2026-W00", "2025-W53", "abcd-W01"} {
+			_, err := schedule.ParseYearWeek(s)
+			assert.ErrorIs(t, err, schedule.ErrInvalidYearWeekString, s)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		type Weeks map[schedule.YearWeek]schedule.YearWeek
+		in := Weeks{w42: w42.Next()}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.Equal(t, `{"2026-W42":"2026-W43"}`, string(b))
+
+		var out Weeks
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		v, err := w42.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "2026-W42", v)
+
+		for _, src := range []interface{}{"2026-W42", []byte("2026-W42"), time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)} {
+			var yw schedule.YearWeek
+			require.NoError(t, yw.Scan(src))
+			assert.Equal(t, w42, yw)
+		}
+	})
+}